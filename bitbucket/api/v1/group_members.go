@@ -3,7 +3,6 @@ package v1
 import (
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
 	"strings"
 )
@@ -54,8 +53,7 @@ func (gm *GroupMembers) Get(gmo *GroupMemberOptions) ([]GroupMember, error) {
 
 	result := make([]GroupMember, 1)
 	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
-		log.Println("Could not unmarshal JSON payload")
-		return nil, err
+		return nil, fmt.Errorf("unable to unmarshal JSON payload with error: %s", err)
 	}
 
 	return result, nil
@@ -84,8 +82,7 @@ func (gm *GroupMembers) Create(gmo *GroupMemberOptions) (*GroupMember, error) {
 
 	result := new(GroupMember)
 	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
-		log.Println("Could not unmarshal JSON payload")
-		return nil, err
+		return nil, fmt.Errorf("unable to unmarshal JSON payload with error: %s", err)
 	}
 
 	return result, nil
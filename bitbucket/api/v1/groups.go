@@ -5,7 +5,6 @@ package v1
 import (
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
 	"strings"
 )
@@ -18,16 +17,20 @@ type Group struct {
 	Owner struct {
 		Uuid string `json:"uuid"`
 	}
-	Name       string `json:"name"`
-	Slug       string `json:"slug"`
-	Permission string `json:"permission"`
+	Name                    string `json:"name"`
+	Slug                    string `json:"slug"`
+	Permission              string `json:"permission"`
+	AutoAdd                 bool   `json:"auto_add"`
+	EmailForwardingDisabled bool   `json:"email_forwarding_disabled"`
 }
 
 type GroupOptions struct {
-	OwnerUuid  string
-	Name       string
-	Slug       string
-	Permission string
+	OwnerUuid               string
+	Name                    string
+	Slug                    string
+	Permission              string
+	AutoAdd                 bool
+	EmailForwardingDisabled bool
 }
 
 func (g *Groups) Get(gro *GroupOptions) (*Group, error) {
@@ -52,8 +55,7 @@ func (g *Groups) Get(gro *GroupOptions) (*Group, error) {
 
 	result := make([]Group, 1)
 	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
-		log.Println("Could not unmarshal JSON payload")
-		return nil, err
+		return nil, fmt.Errorf("unable to unmarshal JSON payload with error: %s", err)
 	}
 	if len(result) == 0 {
 		return nil, fmt.Errorf("no group found")
@@ -66,6 +68,40 @@ func (g *Groups) Get(gro *GroupOptions) (*Group, error) {
 	return &result[0], nil
 }
 
+func (g *Groups) List(gro *GroupOptions) ([]Group, error) {
+	url := fmt.Sprintf("%s/groups/%s", g.client.ApiBaseUrl, gro.OwnerUuid)
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request.SetBasicAuth(g.client.Auth.Username, g.client.Auth.Password)
+
+	response, err := g.client.HttpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	if response.Body == nil {
+		return nil, fmt.Errorf("response body is nil")
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("response status code was not 200")
+	}
+
+	var result []Group
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal JSON payload with error: %s", err)
+	}
+
+	for i := range result {
+		if result[i].Permission == "" {
+			result[i].Permission = "none"
+		}
+	}
+
+	return result, nil
+}
+
 func (g *Groups) Create(gro *GroupOptions) (*Group, error) {
 	url := fmt.Sprintf("%s/groups/%s", g.client.ApiBaseUrl, gro.OwnerUuid)
 	body := strings.NewReader(fmt.Sprintf("name=%s", gro.Name))
@@ -90,8 +126,7 @@ func (g *Groups) Create(gro *GroupOptions) (*Group, error) {
 
 	result := &Group{}
 	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
-		log.Println("Could not unmarshal JSON payload")
-		return nil, err
+		return nil, fmt.Errorf("unable to unmarshal JSON payload with error: %s", err)
 	}
 
 	if result.Permission == "" {
@@ -110,11 +145,15 @@ func (g *Groups) Update(gro *GroupOptions) (*Group, error) {
 	}
 
 	requestBody := struct {
-		Name       string  `json:"name,omitempty"`
-		Permission *string `json:"permission"`
+		Name                    string  `json:"name,omitempty"`
+		Permission              *string `json:"permission"`
+		AutoAdd                 bool    `json:"auto_add"`
+		EmailForwardingDisabled bool    `json:"email_forwarding_disabled"`
 	}{
-		Name:       gro.Name,
-		Permission: groupPermission,
+		Name:                    gro.Name,
+		Permission:              groupPermission,
+		AutoAdd:                 gro.AutoAdd,
+		EmailForwardingDisabled: gro.EmailForwardingDisabled,
 	}
 	requestBodyJson, err := json.Marshal(requestBody)
 	if err != nil {
@@ -143,8 +182,7 @@ func (g *Groups) Update(gro *GroupOptions) (*Group, error) {
 
 	result := &Group{}
 	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
-		log.Println("Could not unmarshal JSON payload")
-		return nil, err
+		return nil, fmt.Errorf("unable to unmarshal JSON payload with error: %s", err)
 	}
 
 	return result, nil
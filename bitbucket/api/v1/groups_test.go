@@ -50,20 +50,56 @@ func TestGroups(t *testing.T) {
 		assert.Equal(t, groupResourceSlug, group.Slug)
 	})
 
+	t.Run("list", func(t *testing.T) {
+		opt := &GroupOptions{
+			OwnerUuid: c.Auth.Username,
+		}
+		groups, err := c.Groups.List(opt)
+
+		assert.NoError(t, err)
+
+		var slugs []string
+		for _, group := range groups {
+			slugs = append(slugs, group.Slug)
+		}
+		assert.Contains(t, slugs, groupResourceSlug)
+	})
+
 	t.Run("update", func(t *testing.T) {
 		opt := &GroupOptions{
 			OwnerUuid:  c.Auth.Username,
 			Slug:       groupResourceSlug,
 			Permission: "write",
+			AutoAdd:    true,
 		}
 		group, err := c.Groups.Update(opt)
 
 		assert.NoError(t, err)
 		assert.Equal(t, name, group.Name)
 		assert.Equal(t, "write", group.Permission)
+		assert.Equal(t, true, group.AutoAdd)
+		assert.Equal(t, false, group.EmailForwardingDisabled)
 		assert.Equal(t, groupResourceSlug, group.Slug)
 	})
 
+	t.Run("rename", func(t *testing.T) {
+		name = "tf-bb-group-test" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+		opt := &GroupOptions{
+			OwnerUuid:  c.Auth.Username,
+			Slug:       groupResourceSlug,
+			Name:       name,
+			Permission: "write",
+		}
+		group, err := c.Groups.Update(opt)
+
+		assert.NoError(t, err)
+		assert.Equal(t, name, group.Name)
+		assert.NotEqual(t, groupResourceSlug, group.Slug)
+
+		groupResourceSlug = group.Slug
+	})
+
 	t.Run("delete", func(t *testing.T) {
 		opt := &GroupOptions{
 			OwnerUuid: c.Auth.Username,
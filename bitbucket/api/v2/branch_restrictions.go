@@ -0,0 +1,103 @@
+package v2
+
+// Implements: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-branch-restrictions/
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type BranchRestrictions struct {
+	client *Client
+}
+
+type BranchRestriction struct {
+	ID              int                      `json:"id,omitempty"`
+	Kind            string                   `json:"kind"`
+	BranchMatchKind string                   `json:"branch_match_kind,omitempty"`
+	BranchType      string                   `json:"branch_type,omitempty"`
+	Pattern         string                   `json:"pattern,omitempty"`
+	Value           *int                     `json:"value,omitempty"`
+	Users           []BranchRestrictionUser  `json:"users"`
+	Groups          []BranchRestrictionGroup `json:"groups"`
+}
+
+type BranchRestrictionUser struct {
+	UUID        string `json:"uuid,omitempty"`
+	Username    string `json:"username,omitempty"`
+	Nickname    string `json:"nickname,omitempty"`
+	DisplayName string `json:"display_name,omitempty"`
+}
+
+type BranchRestrictionGroup struct {
+	Slug     string `json:"slug"`
+	Name     string `json:"name,omitempty"`
+	FullSlug string `json:"full_slug,omitempty"`
+}
+
+type BranchRestrictionOptions struct {
+	Owner             string
+	RepoSlug          string
+	ID                string
+	BranchRestriction *BranchRestriction
+}
+
+func (br *BranchRestrictions) List(bro *BranchRestrictionOptions) ([]BranchRestriction, error) {
+	return listAll[BranchRestriction](br.client, br.path(bro), nil)
+}
+
+func (br *BranchRestrictions) Get(bro *BranchRestrictionOptions) (*BranchRestriction, error) {
+	request, err := br.client.newRequest(http.MethodGet, fmt.Sprintf("%s/%s", br.path(bro), url.PathEscape(bro.ID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(BranchRestriction)
+	if err := br.client.do(request, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (br *BranchRestrictions) Create(bro *BranchRestrictionOptions) (*BranchRestriction, error) {
+	request, err := br.client.newRequest(http.MethodPost, br.path(bro), bro.BranchRestriction)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(BranchRestriction)
+	if err := br.client.do(request, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (br *BranchRestrictions) Update(bro *BranchRestrictionOptions) (*BranchRestriction, error) {
+	request, err := br.client.newRequest(http.MethodPut, fmt.Sprintf("%s/%s", br.path(bro), url.PathEscape(bro.ID)), bro.BranchRestriction)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(BranchRestriction)
+	if err := br.client.do(request, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (br *BranchRestrictions) Delete(bro *BranchRestrictionOptions) error {
+	request, err := br.client.newRequest(http.MethodDelete, fmt.Sprintf("%s/%s", br.path(bro), url.PathEscape(bro.ID)), nil)
+	if err != nil {
+		return err
+	}
+
+	return br.client.do(request, http.StatusNoContent, nil)
+}
+
+func (br *BranchRestrictions) path(bro *BranchRestrictionOptions) string {
+	return fmt.Sprintf("/repositories/%s/%s/branch-restrictions", url.PathEscape(bro.Owner), url.PathEscape(bro.RepoSlug))
+}
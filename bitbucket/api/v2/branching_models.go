@@ -0,0 +1,91 @@
+package v2
+
+// Implements: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-branching-model/
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type BranchingModels struct {
+	client *Client
+}
+
+type BranchingModelSettings struct {
+	Development BranchingModelBranch       `json:"development"`
+	Production  BranchingModelBranch       `json:"production"`
+	BranchTypes []BranchingModelBranchType `json:"branch_types"`
+}
+
+type BranchingModelBranch struct {
+	Name          string `json:"name,omitempty"`
+	UseMainbranch bool   `json:"use_mainbranch"`
+	Enabled       *bool  `json:"enabled,omitempty"`
+	IsValid       *bool  `json:"is_valid,omitempty"`
+}
+
+type BranchingModelBranchType struct {
+	Kind    string `json:"kind"`
+	Prefix  string `json:"prefix,omitempty"`
+	Enabled bool   `json:"enabled"`
+}
+
+type BranchingModelOptions struct {
+	Owner      string
+	RepoSlug   string
+	ProjectKey string
+	Settings   *BranchingModelSettings
+}
+
+func (bm *BranchingModels) GetRepositorySettings(bmo *BranchingModelOptions) (*BranchingModelSettings, error) {
+	return bm.getSettings(bm.repositorySettingsPath(bmo))
+}
+
+func (bm *BranchingModels) UpdateRepositorySettings(bmo *BranchingModelOptions) (*BranchingModelSettings, error) {
+	return bm.updateSettings(bm.repositorySettingsPath(bmo), bmo.Settings)
+}
+
+func (bm *BranchingModels) GetProjectSettings(bmo *BranchingModelOptions) (*BranchingModelSettings, error) {
+	return bm.getSettings(bm.projectSettingsPath(bmo))
+}
+
+func (bm *BranchingModels) UpdateProjectSettings(bmo *BranchingModelOptions) (*BranchingModelSettings, error) {
+	return bm.updateSettings(bm.projectSettingsPath(bmo), bmo.Settings)
+}
+
+func (bm *BranchingModels) repositorySettingsPath(bmo *BranchingModelOptions) string {
+	return fmt.Sprintf("/repositories/%s/%s/branching-model/settings", url.PathEscape(bmo.Owner), url.PathEscape(bmo.RepoSlug))
+}
+
+func (bm *BranchingModels) projectSettingsPath(bmo *BranchingModelOptions) string {
+	return fmt.Sprintf("/workspaces/%s/projects/%s/branching-model/settings", url.PathEscape(bmo.Owner), url.PathEscape(bmo.ProjectKey))
+}
+
+func (bm *BranchingModels) getSettings(path string) (*BranchingModelSettings, error) {
+	request, err := bm.client.newRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(BranchingModelSettings)
+	if err := bm.client.do(request, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (bm *BranchingModels) updateSettings(path string, settings *BranchingModelSettings) (*BranchingModelSettings, error) {
+	request, err := bm.client.newRequest(http.MethodPut, path, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(BranchingModelSettings)
+	if err := bm.client.do(request, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
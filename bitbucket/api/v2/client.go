@@ -0,0 +1,252 @@
+package v2
+
+// Implements the parts of the Bitbucket Cloud 2.0 API that go-bitbucket does not cover.
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type Client struct {
+	Auth *Auth
+
+	ApiBaseUrl         *url.URL
+	InternalApiBaseUrl *url.URL
+	HttpClient         *http.Client
+
+	BranchRestrictions  *BranchRestrictions
+	BranchingModels     *BranchingModels
+	CommitStatuses      *CommitStatuses
+	DefaultReviewers    *DefaultReviewers
+	DeployKeys          *DeployKeys
+	Environments        *Environments
+	Groups              *Groups
+	HookEvents          *HookEvents
+	PipelineVariables   *PipelineVariables
+	PullRequestSettings *PullRequestSettings
+	Refs                *Refs
+	Repositories        *Repositories
+	Source              *Source
+	Webhooks            *Webhooks
+}
+
+type Auth struct {
+	Username string
+	Password string
+}
+
+// UnexpectedResponseError is returned whenever Bitbucket responds with a status code other than the one expected.
+// Its message mirrors go-bitbucket's (e.g. "404 Not Found"), so both clients' errors can be handled alike.
+type UnexpectedResponseError struct {
+	StatusCode int
+	Status     string
+	Body       []byte
+}
+
+func (e *UnexpectedResponseError) Error() string {
+	return e.Status
+}
+
+// paginatedResponse is the envelope Bitbucket wraps every listing in.
+type paginatedResponse[T any] struct {
+	Values []T    `json:"values"`
+	Next   string `json:"next"`
+}
+
+func NewClient(auth *Auth) *Client {
+	apiBaseUrl, err := url.Parse("https://api.bitbucket.org/2.0")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Some settings are only exposed by the API Bitbucket's own UI uses, which lives alongside the 2.0 API.
+	internalApiBaseUrl, err := url.Parse("https://api.bitbucket.org/internal")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	client := &Client{
+		Auth:               auth,
+		ApiBaseUrl:         apiBaseUrl,
+		InternalApiBaseUrl: internalApiBaseUrl,
+	}
+	client.BranchRestrictions = &BranchRestrictions{client: client}
+	client.BranchingModels = &BranchingModels{client: client}
+	client.CommitStatuses = &CommitStatuses{client: client}
+	client.DefaultReviewers = &DefaultReviewers{client: client}
+	client.DeployKeys = &DeployKeys{client: client}
+	client.Environments = &Environments{client: client}
+	client.Groups = &Groups{client: client}
+	client.HookEvents = &HookEvents{client: client}
+	client.PipelineVariables = &PipelineVariables{client: client}
+	client.PullRequestSettings = &PullRequestSettings{client: client}
+	client.Refs = &Refs{client: client}
+	client.Repositories = &Repositories{client: client}
+	client.Source = &Source{client: client}
+	client.Webhooks = &Webhooks{client: client}
+	client.HttpClient = new(http.Client)
+
+	return client
+}
+
+// IsNotFound reports whether err is the result of Bitbucket responding with a 404.
+func IsNotFound(err error) bool {
+	var responseErr *UnexpectedResponseError
+	return errors.As(err, &responseErr) && responseErr.StatusCode == http.StatusNotFound
+}
+
+func (c *Client) newRequest(method string, path string, body interface{}) (*http.Request, error) {
+	return c.newRequestWithBaseUrl(method, c.ApiBaseUrl, path, body)
+}
+
+func (c *Client) newInternalRequest(method string, path string, body interface{}) (*http.Request, error) {
+	return c.newRequestWithBaseUrl(method, c.InternalApiBaseUrl, path, body)
+}
+
+func (c *Client) newRequestWithBaseUrl(method string, baseUrl *url.URL, path string, body interface{}) (*http.Request, error) {
+	if body == nil {
+		return c.newRawRequest(method, baseUrl, path, nil, "")
+	}
+
+	requestBodyJson, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.newRawRequest(method, baseUrl, path, bytes.NewReader(requestBodyJson), "application/json")
+}
+
+// newRawRequest sends the given body as is, for the few endpoints which do not accept JSON.
+func (c *Client) newRawRequest(method string, baseUrl *url.URL, path string, body io.Reader, contentType string) (*http.Request, error) {
+	request, err := http.NewRequest(method, fmt.Sprintf("%s%s", baseUrl, path), body)
+	if err != nil {
+		return nil, err
+	}
+
+	request.SetBasicAuth(c.Auth.Username, c.Auth.Password)
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+
+	return request, nil
+}
+
+func (c *Client) do(request *http.Request, expectedStatusCode int, result interface{}) error {
+	response, err := c.HttpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != expectedStatusCode {
+		body, _ := io.ReadAll(response.Body)
+		return &UnexpectedResponseError{
+			StatusCode: response.StatusCode,
+			Status:     response.Status,
+			Body:       body,
+		}
+	}
+
+	switch result := result.(type) {
+	case nil:
+		return nil
+	case *[]byte:
+		*result, err = io.ReadAll(response.Body)
+		return err
+	default:
+		return json.NewDecoder(response.Body).Decode(result)
+	}
+}
+
+// listAll follows Bitbucket's pagination links, starting at the given path, and returns the values from every page.
+func listAll[T any](c *Client, path string, query url.Values) ([]T, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("pagelen", "100")
+
+	request, err := c.newRequest(http.MethodGet, fmt.Sprintf("%s?%s", path, query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []T
+	for {
+		page := new(paginatedResponse[T])
+		if err := c.do(request, http.StatusOK, page); err != nil {
+			return nil, err
+		}
+		values = append(values, page.Values...)
+
+		if page.Next == "" {
+			return values, nil
+		}
+
+		request, err = http.NewRequest(http.MethodGet, page.Next, nil)
+		if err != nil {
+			return nil, err
+		}
+		request.SetBasicAuth(c.Auth.Username, c.Auth.Password)
+	}
+}
+
+// listAllInternal gets every value from a listing of the internal API, starting at the given path. Its endpoints have
+// been seen to respond with both a bare array & Bitbucket's paginated envelope, so both are handled, following the
+// latter's pagination links.
+func listAllInternal[T any](c *Client, path string) ([]T, error) {
+	request, err := c.newInternalRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []T
+	for {
+		var body []byte
+		if err := c.do(request, http.StatusOK, &body); err != nil {
+			return nil, err
+		}
+
+		if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+			var page []T
+			if err := json.Unmarshal(trimmed, &page); err != nil {
+				return nil, err
+			}
+
+			return append(values, page...), nil
+		}
+
+		page := new(paginatedResponse[T])
+		if err := json.Unmarshal(body, page); err != nil {
+			return nil, err
+		}
+		values = append(values, page.Values...)
+
+		if page.Next == "" {
+			return values, nil
+		}
+
+		request, err = http.NewRequest(http.MethodGet, page.Next, nil)
+		if err != nil {
+			return nil, err
+		}
+		request.SetBasicAuth(c.Auth.Username, c.Auth.Password)
+	}
+}
+
+// escapePath escapes each segment of the given path (e.g. a file path or branch name), leaving the separators between
+// them as they are.
+func escapePath(path string) string {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
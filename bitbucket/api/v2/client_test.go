@@ -0,0 +1,68 @@
+package v2
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClient(t *testing.T) {
+	auth := &Auth{
+		Username: "test",
+		Password: "test",
+	}
+	client := NewClient(auth)
+
+	assert.Equal(t, "https://api.bitbucket.org/2.0", client.ApiBaseUrl.String())
+	assert.Equal(t, "https://api.bitbucket.org/internal", client.InternalApiBaseUrl.String())
+	assert.Equal(t, auth, client.Auth)
+	assert.IsType(t, &BranchRestrictions{}, client.BranchRestrictions)
+	assert.IsType(t, &BranchingModels{}, client.BranchingModels)
+	assert.IsType(t, &CommitStatuses{}, client.CommitStatuses)
+	assert.IsType(t, &DefaultReviewers{}, client.DefaultReviewers)
+	assert.IsType(t, &DeployKeys{}, client.DeployKeys)
+	assert.IsType(t, &Environments{}, client.Environments)
+	assert.IsType(t, &Groups{}, client.Groups)
+	assert.IsType(t, &HookEvents{}, client.HookEvents)
+	assert.IsType(t, &PipelineVariables{}, client.PipelineVariables)
+	assert.IsType(t, &PullRequestSettings{}, client.PullRequestSettings)
+	assert.IsType(t, &Refs{}, client.Refs)
+	assert.IsType(t, &Repositories{}, client.Repositories)
+	assert.IsType(t, &Source{}, client.Source)
+	assert.IsType(t, &Webhooks{}, client.Webhooks)
+	assert.IsType(t, &http.Client{}, client.HttpClient)
+}
+
+func TestIsNotFound(t *testing.T) {
+	assert.True(t, IsNotFound(&UnexpectedResponseError{StatusCode: http.StatusNotFound, Status: "404 Not Found"}))
+	assert.False(t, IsNotFound(&UnexpectedResponseError{StatusCode: http.StatusForbidden, Status: "403 Forbidden"}))
+	assert.False(t, IsNotFound(errors.New("404 Not Found")))
+}
+
+func TestEscapePath(t *testing.T) {
+	assert.Equal(t, "README.md", escapePath("README.md"))
+	assert.Equal(t, "docs/getting%20started.md", escapePath("/docs/getting started.md"))
+	assert.Equal(t, ".github/CODEOWNERS", escapePath(".github/CODEOWNERS"))
+}
+
+// newTestClient returns a client whose requests, to both the 2.0 & internal APIs, are sent to the given handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewClient(&Auth{Username: "user", Password: "password"})
+
+	apiBaseUrl, err := url.Parse(server.URL + "/2.0")
+	assert.NoError(t, err)
+	client.ApiBaseUrl = apiBaseUrl
+
+	internalApiBaseUrl, err := url.Parse(server.URL + "/internal")
+	assert.NoError(t, err)
+	client.InternalApiBaseUrl = internalApiBaseUrl
+
+	return client
+}
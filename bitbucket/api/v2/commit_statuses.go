@@ -0,0 +1,82 @@
+package v2
+
+// Implements: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-commit-statuses/
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type CommitStatuses struct {
+	client *Client
+}
+
+type CommitStatus struct {
+	Key         string `json:"key"`
+	State       string `json:"state"`
+	URL         string `json:"url"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Refname     string `json:"refname,omitempty"`
+	CreatedOn   string `json:"created_on,omitempty"`
+	UpdatedOn   string `json:"updated_on,omitempty"`
+}
+
+type CommitStatusOptions struct {
+	Owner        string
+	RepoSlug     string
+	Commit       string
+	Key          string
+	CommitStatus *CommitStatus
+}
+
+func (cs *CommitStatuses) List(cso *CommitStatusOptions) ([]CommitStatus, error) {
+	return listAll[CommitStatus](cs.client, cs.path(cso), nil)
+}
+
+func (cs *CommitStatuses) Get(cso *CommitStatusOptions) (*CommitStatus, error) {
+	request, err := cs.client.newRequest(http.MethodGet, fmt.Sprintf("%s/build/%s", cs.path(cso), url.PathEscape(cso.Key)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(CommitStatus)
+	if err := cs.client.do(request, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (cs *CommitStatuses) Create(cso *CommitStatusOptions) (*CommitStatus, error) {
+	request, err := cs.client.newRequest(http.MethodPost, fmt.Sprintf("%s/build", cs.path(cso)), cso.CommitStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(CommitStatus)
+	if err := cs.client.do(request, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (cs *CommitStatuses) Update(cso *CommitStatusOptions) (*CommitStatus, error) {
+	request, err := cs.client.newRequest(http.MethodPut, fmt.Sprintf("%s/build/%s", cs.path(cso), url.PathEscape(cso.Key)), cso.CommitStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(CommitStatus)
+	if err := cs.client.do(request, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (cs *CommitStatuses) path(cso *CommitStatusOptions) string {
+	return fmt.Sprintf("/repositories/%s/%s/commit/%s/statuses", url.PathEscape(cso.Owner), url.PathEscape(cso.RepoSlug), url.PathEscape(cso.Commit))
+}
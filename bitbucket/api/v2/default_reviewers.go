@@ -0,0 +1,27 @@
+package v2
+
+// Implements: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pullrequests/#api-repositories-workspace-repo-slug-default-reviewers-get
+
+import (
+	"fmt"
+	"net/url"
+)
+
+type DefaultReviewers struct {
+	client *Client
+}
+
+type DefaultReviewer struct {
+	UUID        string `json:"uuid"`
+	DisplayName string `json:"display_name"`
+	Nickname    string `json:"nickname"`
+}
+
+type DefaultReviewerOptions struct {
+	Owner    string
+	RepoSlug string
+}
+
+func (dr *DefaultReviewers) List(dro *DefaultReviewerOptions) ([]DefaultReviewer, error) {
+	return listAll[DefaultReviewer](dr.client, fmt.Sprintf("/repositories/%s/%s/default-reviewers", url.PathEscape(dro.Owner), url.PathEscape(dro.RepoSlug)), nil)
+}
@@ -0,0 +1,28 @@
+package v2
+
+// Implements: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-deployments/#api-repositories-workspace-repo-slug-deploy-keys-get
+
+import (
+	"fmt"
+	"net/url"
+)
+
+type DeployKeys struct {
+	client *Client
+}
+
+type DeployKey struct {
+	ID      int    `json:"id"`
+	Label   string `json:"label"`
+	Key     string `json:"key"`
+	Comment string `json:"comment"`
+}
+
+type DeployKeyOptions struct {
+	Owner    string
+	RepoSlug string
+}
+
+func (dk *DeployKeys) List(dko *DeployKeyOptions) ([]DeployKey, error) {
+	return listAll[DeployKey](dk.client, fmt.Sprintf("/repositories/%s/%s/deploy-keys", url.PathEscape(dko.Owner), url.PathEscape(dko.RepoSlug)), nil)
+}
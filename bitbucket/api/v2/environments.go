@@ -0,0 +1,31 @@
+package v2
+
+// Implements: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-deployments/#api-repositories-workspace-repo-slug-environments-get
+
+import (
+	"fmt"
+	"net/url"
+)
+
+type Environments struct {
+	client *Client
+}
+
+type Environment struct {
+	UUID            string          `json:"uuid"`
+	Name            string          `json:"name"`
+	EnvironmentType EnvironmentType `json:"environment_type"`
+}
+
+type EnvironmentType struct {
+	Name string `json:"name"`
+}
+
+type EnvironmentOptions struct {
+	Owner    string
+	RepoSlug string
+}
+
+func (e *Environments) List(eo *EnvironmentOptions) ([]Environment, error) {
+	return listAll[Environment](e.client, fmt.Sprintf("/repositories/%s/%s/environments", url.PathEscape(eo.Owner), url.PathEscape(eo.RepoSlug)), nil)
+}
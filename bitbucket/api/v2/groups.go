@@ -0,0 +1,176 @@
+package v2
+
+// Bitbucket's 2.0 API does not cover groups, so this implements the internal workspace group endpoints used by the
+// workspace settings pages, which replace the deprecated 1.0 groups endpoint.
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type Groups struct {
+	client *Client
+}
+
+// Group describes a workspace group. A Permission of "none" means the group has no global permission over the
+// workspace's repositories, which Bitbucket represents as null.
+type Group struct {
+	Name                    string `json:"name"`
+	Slug                    string `json:"slug"`
+	Permission              string `json:"permission"`
+	AutoAdd                 bool   `json:"auto_add"`
+	EmailForwardingDisabled bool   `json:"email_forwarding_disabled"`
+}
+
+type GroupOptions struct {
+	Owner string
+	Slug  string
+	Group *Group
+}
+
+type GroupMember struct {
+	UUID        string `json:"uuid"`
+	AccountID   string `json:"account_id"`
+	DisplayName string `json:"display_name"`
+	Nickname    string `json:"nickname"`
+}
+
+type GroupMemberOptions struct {
+	Owner    string
+	Slug     string
+	UserUUID string
+}
+
+func (g *Groups) List(gro *GroupOptions) ([]Group, error) {
+	result, err := listAllInternal[Group](g.client, g.path(gro))
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range result {
+		normaliseGroupPermission(&result[i])
+	}
+
+	return result, nil
+}
+
+func (g *Groups) Get(gro *GroupOptions) (*Group, error) {
+	request, err := g.client.newInternalRequest(http.MethodGet, g.groupPath(gro), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(Group)
+	if err := g.client.do(request, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+	normaliseGroupPermission(result)
+
+	return result, nil
+}
+
+// Create creates a group with the given name, Bitbucket ignores any of the group's other settings until it is updated.
+func (g *Groups) Create(gro *GroupOptions) (*Group, error) {
+	requestBody := struct {
+		Name string `json:"name"`
+	}{
+		Name: gro.Group.Name,
+	}
+
+	request, err := g.client.newInternalRequest(http.MethodPost, g.path(gro), requestBody)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(Group)
+	if err := g.client.do(request, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+	normaliseGroupPermission(result)
+
+	return result, nil
+}
+
+// Update updates the group with the given slug. Renaming a group also changes its slug, so the returned group's slug
+// should be used to address it from then on.
+func (g *Groups) Update(gro *GroupOptions) (*Group, error) {
+	var permission *string
+	if gro.Group.Permission != "none" {
+		permission = &gro.Group.Permission
+	}
+
+	requestBody := struct {
+		Name                    string  `json:"name,omitempty"`
+		Permission              *string `json:"permission"`
+		AutoAdd                 bool    `json:"auto_add"`
+		EmailForwardingDisabled bool    `json:"email_forwarding_disabled"`
+	}{
+		Name:                    gro.Group.Name,
+		Permission:              permission,
+		AutoAdd:                 gro.Group.AutoAdd,
+		EmailForwardingDisabled: gro.Group.EmailForwardingDisabled,
+	}
+
+	request, err := g.client.newInternalRequest(http.MethodPut, g.groupPath(gro), requestBody)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(Group)
+	if err := g.client.do(request, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+	normaliseGroupPermission(result)
+
+	return result, nil
+}
+
+func (g *Groups) Delete(gro *GroupOptions) error {
+	request, err := g.client.newInternalRequest(http.MethodDelete, g.groupPath(gro), nil)
+	if err != nil {
+		return err
+	}
+
+	return g.client.do(request, http.StatusNoContent, nil)
+}
+
+func (g *Groups) ListMembers(gmo *GroupMemberOptions) ([]GroupMember, error) {
+	return listAllInternal[GroupMember](g.client, g.membersPath(gmo))
+}
+
+func (g *Groups) AddMember(gmo *GroupMemberOptions) error {
+	request, err := g.client.newInternalRequest(http.MethodPut, fmt.Sprintf("%s/%s", g.membersPath(gmo), url.PathEscape(gmo.UserUUID)), struct{}{})
+	if err != nil {
+		return err
+	}
+
+	return g.client.do(request, http.StatusOK, nil)
+}
+
+func (g *Groups) RemoveMember(gmo *GroupMemberOptions) error {
+	request, err := g.client.newInternalRequest(http.MethodDelete, fmt.Sprintf("%s/%s", g.membersPath(gmo), url.PathEscape(gmo.UserUUID)), nil)
+	if err != nil {
+		return err
+	}
+
+	return g.client.do(request, http.StatusNoContent, nil)
+}
+
+func (g *Groups) path(gro *GroupOptions) string {
+	return fmt.Sprintf("/workspaces/%s/groups", url.PathEscape(gro.Owner))
+}
+
+func (g *Groups) groupPath(gro *GroupOptions) string {
+	return fmt.Sprintf("%s/%s", g.path(gro), url.PathEscape(gro.Slug))
+}
+
+func (g *Groups) membersPath(gmo *GroupMemberOptions) string {
+	return fmt.Sprintf("/workspaces/%s/groups/%s/members", url.PathEscape(gmo.Owner), url.PathEscape(gmo.Slug))
+}
+
+func normaliseGroupPermission(group *Group) {
+	if group.Permission == "" {
+		group.Permission = "none"
+	}
+}
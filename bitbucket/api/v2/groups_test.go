@@ -0,0 +1,109 @@
+package v2
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupsList(t *testing.T) {
+	t.Run("bare array", func(t *testing.T) {
+		client := newTestClient(t, func(writer http.ResponseWriter, request *http.Request) {
+			assert.Equal(t, "/internal/workspaces/%7Bworkspace-uuid%7D/groups", request.URL.EscapedPath())
+
+			username, password, _ := request.BasicAuth()
+			assert.Equal(t, "user", username)
+			assert.Equal(t, "password", password)
+
+			_, _ = writer.Write([]byte(`[{"name":"Developers","slug":"developers","permission":"write"},{"name":"Readers","slug":"readers","permission":null}]`))
+		})
+
+		groups, err := client.Groups.List(&GroupOptions{Owner: "{workspace-uuid}"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []Group{
+			{Name: "Developers", Slug: "developers", Permission: "write"},
+			{Name: "Readers", Slug: "readers", Permission: "none"},
+		}, groups)
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		var serverUrl string
+		client := newTestClient(t, func(writer http.ResponseWriter, request *http.Request) {
+			if request.URL.Query().Get("page") == "2" {
+				_, _ = writer.Write([]byte(`{"values":[{"name":"Readers","slug":"readers"}]}`))
+				return
+			}
+
+			_, _ = fmt.Fprintf(writer, `{"values":[{"name":"Developers","slug":"developers","permission":"write"}],"next":"%s/internal/workspaces/workspace/groups?page=2"}`, serverUrl)
+		})
+		serverUrl = client.InternalApiBaseUrl.Scheme + "://" + client.InternalApiBaseUrl.Host
+
+		groups, err := client.Groups.List(&GroupOptions{Owner: "workspace"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []Group{
+			{Name: "Developers", Slug: "developers", Permission: "write"},
+			{Name: "Readers", Slug: "readers", Permission: "none"},
+		}, groups)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		client := newTestClient(t, func(writer http.ResponseWriter, request *http.Request) {
+			writer.WriteHeader(http.StatusNotFound)
+		})
+
+		_, err := client.Groups.List(&GroupOptions{Owner: "workspace"})
+
+		assert.True(t, IsNotFound(err))
+	})
+}
+
+func TestGroupsListMembers(t *testing.T) {
+	t.Run("bare array", func(t *testing.T) {
+		client := newTestClient(t, func(writer http.ResponseWriter, request *http.Request) {
+			assert.Equal(t, "/internal/workspaces/workspace/groups/developers/members", request.URL.EscapedPath())
+
+			_, _ = writer.Write([]byte(`[{"uuid":"{user-uuid}","account_id":"account-id","display_name":"User","nickname":"user"}]`))
+		})
+
+		members, err := client.Groups.ListMembers(&GroupMemberOptions{Owner: "workspace", Slug: "developers"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []GroupMember{{UUID: "{user-uuid}", AccountID: "account-id", DisplayName: "User", Nickname: "user"}}, members)
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		var serverUrl string
+		client := newTestClient(t, func(writer http.ResponseWriter, request *http.Request) {
+			if request.URL.Query().Get("page") == "2" {
+				_, _ = writer.Write([]byte(`{"values":[{"uuid":"{other-uuid}"}]}`))
+				return
+			}
+
+			_, _ = fmt.Fprintf(writer, `{"values":[{"uuid":"{user-uuid}"}],"next":"%s/internal/workspaces/workspace/groups/developers/members?page=2"}`, serverUrl)
+		})
+		serverUrl = client.InternalApiBaseUrl.Scheme + "://" + client.InternalApiBaseUrl.Host
+
+		members, err := client.Groups.ListMembers(&GroupMemberOptions{Owner: "workspace", Slug: "developers"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []GroupMember{{UUID: "{user-uuid}"}, {UUID: "{other-uuid}"}}, members)
+	})
+}
+
+func TestGroupsGet(t *testing.T) {
+	client := newTestClient(t, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodGet, request.Method)
+		assert.Equal(t, "/internal/workspaces/workspace/groups/developers", request.URL.EscapedPath())
+
+		_, _ = writer.Write([]byte(`{"name":"Developers","slug":"developers","permission":null,"auto_add":true}`))
+	})
+
+	group, err := client.Groups.Get(&GroupOptions{Owner: "workspace", Slug: "developers"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &Group{Name: "Developers", Slug: "developers", Permission: "none", AutoAdd: true}, group)
+}
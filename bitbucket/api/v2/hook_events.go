@@ -0,0 +1,24 @@
+package v2
+
+// Implements: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-webhooks/#api-hook-events-subject-type-get
+
+import (
+	"fmt"
+	"net/url"
+)
+
+type HookEvents struct {
+	client *Client
+}
+
+type HookEvent struct {
+	Event       string `json:"event"`
+	Category    string `json:"category"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+}
+
+// List returns every event a webhook can subscribe to for the given subject type, either "repository" or "workspace".
+func (he *HookEvents) List(subjectType string) ([]HookEvent, error) {
+	return listAll[HookEvent](he.client, fmt.Sprintf("/hook_events/%s", url.PathEscape(subjectType)), nil)
+}
@@ -0,0 +1,29 @@
+package v2
+
+// Implements: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/#api-repositories-workspace-repo-slug-pipelines-config-variables-get
+
+import (
+	"fmt"
+	"net/url"
+)
+
+type PipelineVariables struct {
+	client *Client
+}
+
+// PipelineVariable describes a repository's pipeline variable. Bitbucket never returns the value of a secured variable.
+type PipelineVariable struct {
+	UUID    string `json:"uuid"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Secured bool   `json:"secured"`
+}
+
+type PipelineVariableOptions struct {
+	Owner    string
+	RepoSlug string
+}
+
+func (pv *PipelineVariables) List(pvo *PipelineVariableOptions) ([]PipelineVariable, error) {
+	return listAll[PipelineVariable](pv.client, fmt.Sprintf("/repositories/%s/%s/pipelines_config/variables", url.PathEscape(pvo.Owner), url.PathEscape(pvo.RepoSlug)), nil)
+}
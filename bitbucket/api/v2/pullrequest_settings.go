@@ -0,0 +1,58 @@
+package v2
+
+// Bitbucket does not document an API for a repository's merge strategies, so this implements the internal endpoint
+// used by the repository settings page.
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type PullRequestSettings struct {
+	client *Client
+}
+
+type PullRequestSettingsValues struct {
+	MergeStrategies      []string `json:"merge_strategies"`
+	DefaultMergeStrategy string   `json:"default_merge_strategy"`
+	CloseSourceBranch    bool     `json:"close_source_branch"`
+}
+
+type PullRequestSettingsOptions struct {
+	Owner    string
+	RepoSlug string
+	Settings *PullRequestSettingsValues
+}
+
+func (prs *PullRequestSettings) Get(prso *PullRequestSettingsOptions) (*PullRequestSettingsValues, error) {
+	request, err := prs.client.newInternalRequest(http.MethodGet, prs.path(prso), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(PullRequestSettingsValues)
+	if err := prs.client.do(request, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (prs *PullRequestSettings) Update(prso *PullRequestSettingsOptions) (*PullRequestSettingsValues, error) {
+	request, err := prs.client.newInternalRequest(http.MethodPut, prs.path(prso), prso.Settings)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(PullRequestSettingsValues)
+	if err := prs.client.do(request, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (prs *PullRequestSettings) path(prso *PullRequestSettingsOptions) string {
+	return fmt.Sprintf("/repositories/%s/%s/pullrequest-settings", url.PathEscape(prso.Owner), url.PathEscape(prso.RepoSlug))
+}
@@ -0,0 +1,101 @@
+package v2
+
+// Implements: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-refs/
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type Refs struct {
+	client *Client
+}
+
+type Ref struct {
+	Name    string    `json:"name"`
+	Message string    `json:"message,omitempty"`
+	Target  RefTarget `json:"target"`
+}
+
+type RefTarget struct {
+	Hash string `json:"hash"`
+}
+
+type RefOptions struct {
+	Owner    string
+	RepoSlug string
+	Name     string
+	Ref      *Ref
+}
+
+func (r *Refs) GetBranch(ro *RefOptions) (*Ref, error) {
+	return r.get(r.path(ro, "branches"))
+}
+
+func (r *Refs) CreateBranch(ro *RefOptions) (*Ref, error) {
+	return r.create(r.path(ro, "branches"), ro.Ref)
+}
+
+func (r *Refs) DeleteBranch(ro *RefOptions) error {
+	return r.delete(r.path(ro, "branches"))
+}
+
+func (r *Refs) GetTag(ro *RefOptions) (*Ref, error) {
+	return r.get(r.path(ro, "tags"))
+}
+
+func (r *Refs) CreateTag(ro *RefOptions) (*Ref, error) {
+	return r.create(r.path(ro, "tags"), ro.Ref)
+}
+
+func (r *Refs) DeleteTag(ro *RefOptions) error {
+	return r.delete(r.path(ro, "tags"))
+}
+
+// path returns the path of the named ref of the given type, or of all refs of that type if no name is given.
+func (r *Refs) path(ro *RefOptions, refType string) string {
+	path := fmt.Sprintf("/repositories/%s/%s/refs/%s", url.PathEscape(ro.Owner), url.PathEscape(ro.RepoSlug), refType)
+	if ro.Name != "" {
+		path = fmt.Sprintf("%s/%s", path, escapePath(ro.Name))
+	}
+
+	return path
+}
+
+func (r *Refs) get(path string) (*Ref, error) {
+	request, err := r.client.newRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(Ref)
+	if err := r.client.do(request, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *Refs) create(path string, ref *Ref) (*Ref, error) {
+	request, err := r.client.newRequest(http.MethodPost, path, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(Ref)
+	if err := r.client.do(request, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *Refs) delete(path string) error {
+	request, err := r.client.newRequest(http.MethodDelete, path, nil)
+	if err != nil {
+		return err
+	}
+
+	return r.client.do(request, http.StatusNoContent, nil)
+}
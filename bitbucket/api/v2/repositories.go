@@ -0,0 +1,105 @@
+package v2
+
+// Implements: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-repositories/
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type Repositories struct {
+	client *Client
+}
+
+type Repository struct {
+	UUID          string             `json:"uuid,omitempty"`
+	Name          string             `json:"name,omitempty"`
+	Slug          string             `json:"slug,omitempty"`
+	Description   *string            `json:"description,omitempty"`
+	IsPrivate     *bool              `json:"is_private,omitempty"`
+	HasWiki       *bool              `json:"has_wiki,omitempty"`
+	HasIssues     *bool              `json:"has_issues,omitempty"`
+	ForkPolicy    string             `json:"fork_policy,omitempty"`
+	NoForks       *bool              `json:"no_forks,omitempty"`
+	NoPublicForks *bool              `json:"no_public_forks,omitempty"`
+	Language      *string            `json:"language,omitempty"`
+	Project       *RepositoryProject `json:"project,omitempty"`
+	Mainbranch    *RepositoryBranch  `json:"mainbranch,omitempty"`
+}
+
+type RepositoryProject struct {
+	Key string `json:"key"`
+}
+
+type RepositoryBranch struct {
+	Name string `json:"name"`
+	Type string `json:"type,omitempty"`
+}
+
+// RepositoryOptions addresses a repository by its slug, or by its UUID (including the enclosing `{}`).
+type RepositoryOptions struct {
+	Owner      string
+	RepoSlug   string
+	Repository *Repository
+}
+
+// RepositoryListOptions filters the repositories listed, where Query is written in Bitbucket's query language (BBQL).
+// See: https://developer.atlassian.com/cloud/bitbucket/rest/intro/#filtering
+type RepositoryListOptions struct {
+	Owner string
+	Query string
+	Sort  string
+	Role  string
+}
+
+func (r *Repositories) List(rlo *RepositoryListOptions) ([]Repository, error) {
+	query := url.Values{}
+	if rlo.Query != "" {
+		query.Set("q", rlo.Query)
+	}
+	if rlo.Sort != "" {
+		query.Set("sort", rlo.Sort)
+	}
+	if rlo.Role != "" {
+		query.Set("role", rlo.Role)
+	}
+
+	return listAll[Repository](r.client, fmt.Sprintf("/repositories/%s", url.PathEscape(rlo.Owner)), query)
+}
+
+// Create creates a repository with the given slug, which should be derived from the repository's name in the same way
+// Bitbucket does so that they stay in sync.
+func (r *Repositories) Create(ro *RepositoryOptions) (*Repository, error) {
+	request, err := r.client.newRequest(http.MethodPost, r.path(ro), ro.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(Repository)
+	if err := r.client.do(request, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Update only changes the fields which are set on the given repository, leaving all others as they are. Changing
+// the name of a repository also changes its slug, which is returned.
+func (r *Repositories) Update(ro *RepositoryOptions) (*Repository, error) {
+	request, err := r.client.newRequest(http.MethodPut, r.path(ro), ro.Repository)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(Repository)
+	if err := r.client.do(request, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *Repositories) path(ro *RepositoryOptions) string {
+	return fmt.Sprintf("/repositories/%s/%s", url.PathEscape(ro.Owner), url.PathEscape(ro.RepoSlug))
+}
@@ -0,0 +1,105 @@
+package v2
+
+// Implements: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-source/
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type Source struct {
+	client *Client
+}
+
+type SourceOptions struct {
+	Owner    string
+	RepoSlug string
+	Commit   string
+	Path     string
+}
+
+// SourceCommitOptions describes a commit which creates or updates the files in Files & deletes the files in
+// DeletedFiles, with both being keyed on their path within the repository, with or without a leading `/`.
+type SourceCommitOptions struct {
+	Owner        string
+	RepoSlug     string
+	Branch       string
+	Message      string
+	Author       string
+	Files        map[string]string
+	DeletedFiles []string
+}
+
+func (s *Source) GetFileContent(so *SourceOptions) ([]byte, error) {
+	request, err := s.client.newRequest(
+		http.MethodGet,
+		fmt.Sprintf("%s/%s/%s", s.path(so.Owner, so.RepoSlug), url.PathEscape(so.Commit), escapePath(so.Path)),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []byte
+	if err := s.client.do(request, http.StatusOK, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Source) Commit(sco *SourceCommitOptions) error {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+
+	fields := map[string]string{
+		"branch":  sco.Branch,
+		"message": sco.Message,
+		"author":  sco.Author,
+	}
+	for name, value := range fields {
+		if value == "" {
+			continue
+		}
+		if err := writer.WriteField(name, value); err != nil {
+			return err
+		}
+	}
+
+	// Files are sent as fields named after their absolute path, so that they cannot be mistaken for the commit's own
+	// fields, e.g. a file named `message`.
+	for path, content := range sco.Files {
+		if err := writer.WriteField(absolutePath(path), content); err != nil {
+			return err
+		}
+	}
+
+	for _, path := range sco.DeletedFiles {
+		if err := writer.WriteField("files", absolutePath(path)); err != nil {
+			return err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	request, err := s.client.newRawRequest(http.MethodPost, s.client.ApiBaseUrl, s.path(sco.Owner, sco.RepoSlug), body, writer.FormDataContentType())
+	if err != nil {
+		return err
+	}
+
+	return s.client.do(request, http.StatusCreated, nil)
+}
+
+func (s *Source) path(owner string, repoSlug string) string {
+	return fmt.Sprintf("/repositories/%s/%s/src", url.PathEscape(owner), url.PathEscape(repoSlug))
+}
+
+func absolutePath(path string) string {
+	return "/" + strings.TrimPrefix(path, "/")
+}
@@ -0,0 +1,38 @@
+package v2
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSourceCommit(t *testing.T) {
+	client := newTestClient(t, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, http.MethodPost, request.Method)
+		assert.Equal(t, "/2.0/repositories/workspace/repository/src", request.URL.EscapedPath())
+		assert.NoError(t, request.ParseMultipartForm(1024))
+
+		assert.Equal(t, []string{"main"}, request.MultipartForm.Value["branch"])
+		assert.Equal(t, []string{"Managed by Terraform"}, request.MultipartForm.Value["message"])
+		assert.Equal(t, []string{"message content"}, request.MultipartForm.Value["/message"])
+		assert.Equal(t, []string{"readme content"}, request.MultipartForm.Value["/docs/README.md"])
+		assert.Equal(t, []string{"/files"}, request.MultipartForm.Value["files"])
+		assert.NotContains(t, request.MultipartForm.Value, "author")
+
+		writer.WriteHeader(http.StatusCreated)
+	})
+
+	err := client.Source.Commit(&SourceCommitOptions{
+		Owner:    "workspace",
+		RepoSlug: "repository",
+		Branch:   "main",
+		Message:  "Managed by Terraform",
+		Files: map[string]string{
+			"message":         "message content",
+			"/docs/README.md": "readme content",
+		},
+		DeletedFiles: []string{"files"},
+	})
+	assert.NoError(t, err)
+}
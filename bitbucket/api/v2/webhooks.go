@@ -0,0 +1,97 @@
+package v2
+
+// Implements: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-webhooks/
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type Webhooks struct {
+	client *Client
+}
+
+// Webhook describes a webhook. Bitbucket never returns its secret, only whether one is set, so Secret is only sent
+// when it is not nil, with an empty secret removing it.
+type Webhook struct {
+	UUID                 string   `json:"uuid,omitempty"`
+	Description          string   `json:"description"`
+	URL                  string   `json:"url"`
+	Active               bool     `json:"active"`
+	Events               []string `json:"events"`
+	Secret               *string  `json:"secret,omitempty"`
+	SecretSet            bool     `json:"secret_set,omitempty"`
+	SkipCertVerification bool     `json:"skip_cert_verification"`
+}
+
+// WebhookOptions addresses the webhooks of a repository, or of a whole workspace when no RepoSlug is given.
+type WebhookOptions struct {
+	Owner    string
+	RepoSlug string
+	UUID     string
+	Webhook  *Webhook
+}
+
+func (w *Webhooks) List(wo *WebhookOptions) ([]Webhook, error) {
+	return listAll[Webhook](w.client, w.path(wo), nil)
+}
+
+func (w *Webhooks) Get(wo *WebhookOptions) (*Webhook, error) {
+	request, err := w.client.newRequest(http.MethodGet, fmt.Sprintf("%s/%s", w.path(wo), url.PathEscape(wo.UUID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(Webhook)
+	if err := w.client.do(request, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (w *Webhooks) Create(wo *WebhookOptions) (*Webhook, error) {
+	request, err := w.client.newRequest(http.MethodPost, w.path(wo), wo.Webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(Webhook)
+	if err := w.client.do(request, http.StatusCreated, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (w *Webhooks) Update(wo *WebhookOptions) (*Webhook, error) {
+	request, err := w.client.newRequest(http.MethodPut, fmt.Sprintf("%s/%s", w.path(wo), url.PathEscape(wo.UUID)), wo.Webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(Webhook)
+	if err := w.client.do(request, http.StatusOK, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (w *Webhooks) Delete(wo *WebhookOptions) error {
+	request, err := w.client.newRequest(http.MethodDelete, fmt.Sprintf("%s/%s", w.path(wo), url.PathEscape(wo.UUID)), nil)
+	if err != nil {
+		return err
+	}
+
+	return w.client.do(request, http.StatusNoContent, nil)
+}
+
+func (w *Webhooks) path(wo *WebhookOptions) string {
+	if wo.RepoSlug == "" {
+		return fmt.Sprintf("/workspaces/%s/hooks", url.PathEscape(wo.Owner))
+	}
+
+	return fmt.Sprintf("/repositories/%s/%s/hooks", url.PathEscape(wo.Owner), url.PathEscape(wo.RepoSlug))
+}
@@ -0,0 +1,39 @@
+package bitbucket
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceBitbucketBranch() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: resourceBitbucketBranchRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the branch.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"workspace": {
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"repository": {
+				Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateRepositoryName,
+			},
+			"name": {
+				Description: "The name of the branch.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"hash": {
+				Description: "The hash of the commit the branch currently points to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
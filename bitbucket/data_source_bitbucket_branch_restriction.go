@@ -1,17 +1,27 @@
 package bitbucket
 
 import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
 )
 
 func dataSourceBitbucketBranchRestriction() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: resourceBitbucketBranchRestrictionRead,
+		ReadContext: dataSourceBitbucketBranchRestrictionRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Description: "The ID of the branch restriction.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:   "The ID of the branch restriction.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"kind", "pattern"},
+				AtLeastOneOf:  []string{"id", "kind"},
 			},
 			"workspace": {
 				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
@@ -24,21 +34,78 @@ func dataSourceBitbucketBranchRestriction() *schema.Resource {
 				Required:         true,
 				ValidateDiagFunc: validateRepositoryName,
 			},
-			"pattern": {
-				Description: "The pattern to match against branches this restriction will apply to.",
+			"branch_match_kind": {
+				Description: "How branches this restriction will apply to are matched, either 'glob' (matches `pattern`) or 'branching_model' (matches `branch_type`).",
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
-			"kind": {
-				Description: "The type of restriction to apply.",
+			"pattern": {
+				Description:  "The pattern to match against branches this restriction will apply to.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"kind", "pattern"},
+			},
+			"branch_type": {
+				Description: "The branch type from the repository's branching model this restriction will apply to.",
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"kind": {
+				Description:  "The type of restriction to apply.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"kind", "pattern"},
+			},
 			"value": {
 				Description: "A configurable value used by the following restrictions: `require_passing_builds_to_merge` uses it to define the number of minimum number of passing builds, `require_approvals_to_merge` uses it to define the minimum number of approvals before the PR can be merged, `require_default_reviewer_approvals_to_merge` uses it to define the minimum number of approvals from default reviewers before the PR can be merged.",
 				Type:        schema.TypeInt,
 				Computed:    true,
 			},
+			"users": {
+				Description: "A set of user UUIDs that are exempt from this branch restriction.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"groups": {
+				Description: "A set of group slugs that are exempt from this branch restriction.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
 		},
 	}
 }
+
+func dataSourceBitbucketBranchRestrictionRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if resourceData.Get("id").(string) == "" {
+		branchRestrictions, err := meta.(*Clients).V2Ext.BranchRestrictions.List(
+			&v2.BranchRestrictionOptions{
+				Owner:    resourceData.Get("workspace").(string),
+				RepoSlug: resourceData.Get("repository").(string),
+			},
+		)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("unable to get branch restrictions with error: %s", err))
+		}
+
+		kind := resourceData.Get("kind").(string)
+		pattern := resourceData.Get("pattern").(string)
+		branchRestriction, err := findOne(branchRestrictions, fmt.Sprintf("%q branch restrictions with pattern %q", kind, pattern), func(branchRestriction v2.BranchRestriction) bool {
+			return branchRestriction.Kind == kind && branchRestriction.Pattern == pattern
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_ = resourceData.Set("id", strconv.Itoa(branchRestriction.ID))
+	}
+
+	return resourceBitbucketBranchRestrictionRead(ctx, resourceData, meta)
+}
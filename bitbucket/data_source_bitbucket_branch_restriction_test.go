@@ -52,6 +52,13 @@ func TestAccBitbucketBranchRestrictionDataSource_basic(t *testing.T) {
 					  workspace  = data.bitbucket_workspace.testacc.id
 					  repository = bitbucket_repository.testacc.name
 					  id         = bitbucket_branch_restriction.testacc.id
+					}
+	
+					data "bitbucket_branch_restriction" "by_kind_and_pattern" {
+					  kind       = bitbucket_branch_restriction.testacc.kind
+					  pattern    = bitbucket_branch_restriction.testacc.pattern
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.name
 					}`, workspaceSlug, projectName, projectKey, repoName, branchRestrictionPattern, branchRestrictionKind),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.bitbucket_branch_restriction.testacc", "workspace", workspaceSlug),
@@ -64,6 +71,8 @@ func TestAccBitbucketBranchRestrictionDataSource_basic(t *testing.T) {
 					resource.TestCheckNoResourceAttr("data.bitbucket_branch_restriction.testacc", "groups"),
 
 					resource.TestCheckResourceAttrSet("data.bitbucket_branch_restriction.testacc", "id"),
+
+					resource.TestCheckResourceAttrPair("data.bitbucket_branch_restriction.by_kind_and_pattern", "id", "data.bitbucket_branch_restriction.testacc", "id"),
 				),
 			},
 		},
@@ -0,0 +1,126 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+func dataSourceBitbucketBranchRestrictions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBitbucketBranchRestrictionsRead,
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"repository": {
+				Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateRepositoryName,
+			},
+			"branch_restrictions": {
+				Description: "List of Branch Restrictions.",
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The Branch Restriction's ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"branch_match_kind": {
+							Description: "How branches the Branch Restriction applies to are matched, either 'glob' or 'branching_model'.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"pattern": {
+							Description: "The pattern branches are matched against.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"branch_type": {
+							Description: "The branch type from the repository's branching model branches are matched against.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"kind": {
+							Description: "The type of restriction.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"value": {
+							Description: "The value used by restrictions which require a number of approvals or passing builds.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"users": {
+							Description: "A set of user UUIDs that are exempt from the Branch Restriction.",
+							Type:        schema.TypeSet,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+						},
+						"groups": {
+							Description: "A set of group slugs that are exempt from the Branch Restriction.",
+							Type:        schema.TypeSet,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBitbucketBranchRestrictionsRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	workspace := resourceData.Get("workspace").(string)
+	repository := resourceData.Get("repository").(string)
+
+	branchRestrictions, err := client.BranchRestrictions.List(
+		&v2.BranchRestrictionOptions{
+			Owner:    workspace,
+			RepoSlug: repository,
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to get branch restrictions with error: %s", err))
+	}
+
+	var flattenedBranchRestrictions []interface{}
+	for _, branchRestriction := range branchRestrictions {
+		flattenedBranchRestriction := map[string]interface{}{
+			"id":                strconv.Itoa(branchRestriction.ID),
+			"branch_match_kind": branchRestriction.BranchMatchKind,
+			"pattern":           branchRestriction.Pattern,
+			"branch_type":       branchRestriction.BranchType,
+			"kind":              branchRestriction.Kind,
+			"users":             flattenBranchRestrictionUsers(branchRestriction.Users),
+			"groups":            flattenBranchRestrictionGroups(branchRestriction.Groups),
+		}
+		if branchRestriction.Value != nil {
+			flattenedBranchRestriction["value"] = *branchRestriction.Value
+		}
+
+		flattenedBranchRestrictions = append(flattenedBranchRestrictions, flattenedBranchRestriction)
+	}
+
+	resourceData.SetId(fmt.Sprintf("%s/%s", workspace, repository))
+	_ = resourceData.Set("branch_restrictions", flattenedBranchRestrictions)
+
+	return nil
+}
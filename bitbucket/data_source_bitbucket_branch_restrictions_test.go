@@ -0,0 +1,71 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBitbucketBranchRestrictionsDataSource_basic(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	projectName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectKey := strings.ToUpper(acctest.RandStringFromCharSet(3, acctest.CharSetAlpha))
+	repoName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_project" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  name       = "%s"
+					  key        = "%s"
+					  is_private = true
+					}
+
+					resource "bitbucket_repository" "testacc" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  project_key = bitbucket_project.testacc.key
+					  name        = "%s"
+					}
+
+					resource "bitbucket_branch_restriction" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.name
+					  pattern    = "master"
+					  kind       = "require_approvals_to_merge"
+					  value      = 2
+					}
+
+					data "bitbucket_branch_restrictions" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.name
+
+					  depends_on = [bitbucket_branch_restriction.testacc]
+					}`, workspaceSlug, projectName, projectKey, repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bitbucket_branch_restrictions.testacc", "id", fmt.Sprintf("%s/%s", workspaceSlug, repoName)),
+					resource.TestCheckResourceAttr("data.bitbucket_branch_restrictions.testacc", "workspace", workspaceSlug),
+					resource.TestCheckResourceAttr("data.bitbucket_branch_restrictions.testacc", "repository", repoName),
+
+					resource.TestCheckResourceAttr("data.bitbucket_branch_restrictions.testacc", "branch_restrictions.#", "1"),
+					resource.TestCheckResourceAttrPair("data.bitbucket_branch_restrictions.testacc", "branch_restrictions.0.id", "bitbucket_branch_restriction.testacc", "id"),
+					resource.TestCheckResourceAttr("data.bitbucket_branch_restrictions.testacc", "branch_restrictions.0.branch_match_kind", "glob"),
+					resource.TestCheckResourceAttr("data.bitbucket_branch_restrictions.testacc", "branch_restrictions.0.pattern", "master"),
+					resource.TestCheckResourceAttr("data.bitbucket_branch_restrictions.testacc", "branch_restrictions.0.kind", "require_approvals_to_merge"),
+					resource.TestCheckResourceAttr("data.bitbucket_branch_restrictions.testacc", "branch_restrictions.0.value", "2"),
+				),
+			},
+		},
+	})
+}
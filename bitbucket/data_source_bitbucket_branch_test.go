@@ -0,0 +1,70 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBitbucketBranchDataSource_basic(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	projectName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectKey := strings.ToUpper(acctest.RandStringFromCharSet(3, acctest.CharSetAlpha))
+	repoName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	branchName := "release/2026.10"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_project" "testacc" {
+					  workspace = data.bitbucket_workspace.testacc.id
+					  name      = "%s"
+					  key       = "%s"
+					}
+
+					resource "bitbucket_repository" "testacc" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  project_key = bitbucket_project.testacc.key
+					  name        = "%s"
+					}
+
+					resource "bitbucket_repository_file" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.slug
+					  branch     = "master"
+					  path       = "README.md"
+					  content    = "# TF ACC Test Repository"
+					}
+
+					resource "bitbucket_branch" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository_file.testacc.repository
+					  name       = "%s"
+					  target     = bitbucket_repository_file.testacc.branch
+					}
+
+					data "bitbucket_branch" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_branch.testacc.repository
+					  name       = bitbucket_branch.testacc.name
+					}`, workspaceSlug, projectName, projectKey, repoName, branchName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bitbucket_branch.testacc", "id", fmt.Sprintf("%s/%s/%s", workspaceSlug, repoName, branchName)),
+					resource.TestCheckResourceAttr("data.bitbucket_branch.testacc", "name", branchName),
+					resource.TestCheckResourceAttrPair("data.bitbucket_branch.testacc", "hash", "bitbucket_branch.testacc", "hash"),
+				),
+			},
+		},
+	})
+}
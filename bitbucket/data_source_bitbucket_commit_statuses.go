@@ -0,0 +1,150 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+func dataSourceBitbucketCommitStatuses() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBitbucketCommitStatusesRead,
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"repository": {
+				Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateRepositoryName,
+			},
+			"commit": {
+				Description:  "The hash of the commit to get the statuses of.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"commit", "branch"},
+			},
+			"branch": {
+				Description:  "The name of a branch, to get the statuses of the commit it currently points to.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"commit", "branch"},
+			},
+			"hash": {
+				Description: "The hash of the commit the statuses belong to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"statuses": {
+				Description: "List of Commit Statuses.",
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Description: "The key that uniquely identifies the build amongst the commit's statuses.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"state": {
+							Description: "The state of the build. One of 'SUCCESSFUL', 'FAILED', 'INPROGRESS', 'STOPPED'.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"url": {
+							Description: "The url of the build.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the build.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "The description of the build.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"refname": {
+							Description: "The name of the branch or tag the build was run for.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_on": {
+							Description: "When the status was first reported.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"updated_on": {
+							Description: "When the status was last updated.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBitbucketCommitStatusesRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	workspace := resourceData.Get("workspace").(string)
+	repository := resourceData.Get("repository").(string)
+
+	hash := resourceData.Get("commit").(string)
+	if branchName := resourceData.Get("branch").(string); branchName != "" {
+		branch, err := client.Refs.GetBranch(
+			&v2.RefOptions{
+				Owner:    workspace,
+				RepoSlug: repository,
+				Name:     branchName,
+			},
+		)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("unable to get branch for commit statuses with error: %s", err))
+		}
+
+		hash = branch.Target.Hash
+	}
+
+	commitStatuses, err := client.CommitStatuses.List(
+		&v2.CommitStatusOptions{
+			Owner:    workspace,
+			RepoSlug: repository,
+			Commit:   hash,
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to get commit statuses with error: %s", err))
+	}
+
+	var statuses []interface{}
+	for _, commitStatus := range commitStatuses {
+		statuses = append(statuses, map[string]interface{}{
+			"key":         commitStatus.Key,
+			"state":       commitStatus.State,
+			"url":         commitStatus.URL,
+			"name":        commitStatus.Name,
+			"description": commitStatus.Description,
+			"refname":     commitStatus.Refname,
+			"created_on":  commitStatus.CreatedOn,
+			"updated_on":  commitStatus.UpdatedOn,
+		})
+	}
+
+	resourceData.SetId(fmt.Sprintf("%s/%s/%s", workspace, repository, hash))
+	_ = resourceData.Set("hash", hash)
+	_ = resourceData.Set("statuses", statuses)
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBitbucketCommitStatusesDataSource_basic(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	projectName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectKey := strings.ToUpper(acctest.RandStringFromCharSet(3, acctest.CharSetAlpha))
+	repoName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_project" "testacc" {
+					  workspace = data.bitbucket_workspace.testacc.id
+					  name      = "%s"
+					  key       = "%s"
+					}
+
+					resource "bitbucket_repository" "testacc" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  project_key = bitbucket_project.testacc.key
+					  name        = "%s"
+					}
+
+					resource "bitbucket_repository_file" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.slug
+					  branch     = "master"
+					  path       = "README.md"
+					  content    = "# TF ACC Test Repository"
+					}
+
+					data "bitbucket_branch" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository_file.testacc.repository
+					  name       = bitbucket_repository_file.testacc.branch
+					}
+
+					resource "bitbucket_commit_status" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.slug
+					  commit     = data.bitbucket_branch.testacc.hash
+					  key        = "tf-acc-test"
+					  state      = "SUCCESSFUL"
+					  url        = "https://example.com/builds/1"
+					}
+
+					data "bitbucket_commit_statuses" "by_commit" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.slug
+					  commit     = bitbucket_commit_status.testacc.commit
+					}
+
+					data "bitbucket_commit_statuses" "by_branch" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.slug
+					  branch     = "master"
+
+					  depends_on = [bitbucket_commit_status.testacc]
+					}`, workspaceSlug, projectName, projectKey, repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.bitbucket_commit_statuses.by_commit", "hash", "data.bitbucket_branch.testacc", "hash"),
+					resource.TestCheckResourceAttr("data.bitbucket_commit_statuses.by_commit", "statuses.#", "1"),
+					resource.TestCheckResourceAttr("data.bitbucket_commit_statuses.by_commit", "statuses.0.key", "tf-acc-test"),
+					resource.TestCheckResourceAttr("data.bitbucket_commit_statuses.by_commit", "statuses.0.state", "SUCCESSFUL"),
+					resource.TestCheckResourceAttr("data.bitbucket_commit_statuses.by_commit", "statuses.0.url", "https://example.com/builds/1"),
+
+					resource.TestCheckResourceAttrPair("data.bitbucket_commit_statuses.by_branch", "hash", "data.bitbucket_branch.testacc", "hash"),
+					resource.TestCheckResourceAttr("data.bitbucket_commit_statuses.by_branch", "statuses.#", "1"),
+					resource.TestCheckResourceAttr("data.bitbucket_commit_statuses.by_branch", "statuses.0.key", "tf-acc-test"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,85 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+func dataSourceBitbucketDefaultReviewers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBitbucketDefaultReviewersRead,
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"repository": {
+				Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateRepositoryName,
+			},
+			"reviewers": {
+				Description: "List of Default Reviewers.",
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user": {
+							Description: "The Default Reviewer's UUID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"display_name": {
+							Description: "The Default Reviewer's display name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"nickname": {
+							Description: "The Default Reviewer's nickname.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBitbucketDefaultReviewersRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	workspace := resourceData.Get("workspace").(string)
+	repository := resourceData.Get("repository").(string)
+
+	defaultReviewers, err := client.DefaultReviewers.List(
+		&v2.DefaultReviewerOptions{
+			Owner:    workspace,
+			RepoSlug: repository,
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to get default reviewers with error: %s", err))
+	}
+
+	var reviewers []interface{}
+	for _, defaultReviewer := range defaultReviewers {
+		reviewers = append(reviewers, map[string]interface{}{
+			"user":         defaultReviewer.UUID,
+			"display_name": defaultReviewer.DisplayName,
+			"nickname":     defaultReviewer.Nickname,
+		})
+	}
+
+	resourceData.SetId(fmt.Sprintf("%s/%s", workspace, repository))
+	_ = resourceData.Set("reviewers", reviewers)
+
+	return nil
+}
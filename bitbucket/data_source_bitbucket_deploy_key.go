@@ -1,17 +1,26 @@
 package bitbucket
 
 import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
 )
 
 func dataSourceBitbucketDeployKey() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: resourceBitbucketDeployKeyRead,
+		ReadContext: dataSourceBitbucketDeployKeyRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Description: "The ID of the deploy key.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "The ID of the deploy key.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "label"},
 			},
 			"workspace": {
 				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
@@ -25,9 +34,11 @@ func dataSourceBitbucketDeployKey() *schema.Resource {
 				ValidateDiagFunc: validateRepositoryName,
 			},
 			"label": {
-				Description: "The label of the deploy key.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description:  "The label of the deploy key.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "label"},
 			},
 			"key": {
 				Description: "The public SSH key to attach to this deploy key.",
@@ -38,3 +49,29 @@ func dataSourceBitbucketDeployKey() *schema.Resource {
 		},
 	}
 }
+
+func dataSourceBitbucketDeployKeyRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if resourceData.Get("id").(string) == "" {
+		deployKeys, err := meta.(*Clients).V2Ext.DeployKeys.List(
+			&v2.DeployKeyOptions{
+				Owner:    resourceData.Get("workspace").(string),
+				RepoSlug: resourceData.Get("repository").(string),
+			},
+		)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("unable to get deploy keys with error: %s", err))
+		}
+
+		label := resourceData.Get("label").(string)
+		deployKey, err := findOne(deployKeys, fmt.Sprintf("deploy keys labelled %q", label), func(deployKey v2.DeployKey) bool {
+			return deployKey.Label == label
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_ = resourceData.Set("id", strconv.Itoa(deployKey.ID))
+	}
+
+	return resourceBitbucketDeployKeyRead(ctx, resourceData, meta)
+}
@@ -52,6 +52,12 @@ func TestAccBitbucketDeployKeyDataSource_basic(t *testing.T) {
 					  id         = bitbucket_deploy_key.testacc.id
 					  workspace  = data.bitbucket_workspace.testacc.id
 					  repository = bitbucket_repository.testacc.name
+					}
+	
+					data "bitbucket_deploy_key" "by_label" {
+					  label      = bitbucket_deploy_key.testacc.label
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.name
 					}`, workspaceSlug, projectName, projectKey, repoName, deployKeyLabel, deployKeyPublicSSHKey),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.bitbucket_deploy_key.testacc", "workspace", workspaceSlug),
@@ -60,6 +66,8 @@ func TestAccBitbucketDeployKeyDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.bitbucket_deploy_key.testacc", "key", deployKeyPublicSSHKey),
 
 					resource.TestCheckResourceAttrSet("data.bitbucket_deploy_key.testacc", "id"),
+
+					resource.TestCheckResourceAttrPair("data.bitbucket_deploy_key.by_label", "id", "data.bitbucket_deploy_key.testacc", "id"),
 				),
 			},
 		},
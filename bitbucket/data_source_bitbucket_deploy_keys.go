@@ -0,0 +1,92 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+func dataSourceBitbucketDeployKeys() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBitbucketDeployKeysRead,
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"repository": {
+				Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateRepositoryName,
+			},
+			"deploy_keys": {
+				Description: "List of Deploy Keys.",
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The Deploy Key's ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"label": {
+							Description: "The Deploy Key's label.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"key": {
+							Description: "The Deploy Key's public SSH key.",
+							Type:        schema.TypeString,
+							Computed:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBitbucketDeployKeysRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	workspace := resourceData.Get("workspace").(string)
+	repository := resourceData.Get("repository").(string)
+
+	deployKeys, err := client.DeployKeys.List(
+		&v2.DeployKeyOptions{
+			Owner:    workspace,
+			RepoSlug: repository,
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to get deploy keys with error: %s", err))
+	}
+
+	var flattenedDeployKeys []interface{}
+	for _, deployKey := range deployKeys {
+		key := deployKey.Key
+		if deployKey.Comment != "" {
+			key = fmt.Sprintf("%s %s", deployKey.Key, deployKey.Comment)
+		}
+
+		flattenedDeployKeys = append(flattenedDeployKeys, map[string]interface{}{
+			"id":    strconv.Itoa(deployKey.ID),
+			"label": deployKey.Label,
+			"key":   key,
+		})
+	}
+
+	resourceData.SetId(fmt.Sprintf("%s/%s", workspace, repository))
+	_ = resourceData.Set("deploy_keys", flattenedDeployKeys)
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBitbucketDeployKeysDataSource_basic(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	projectName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectKey := strings.ToUpper(acctest.RandStringFromCharSet(3, acctest.CharSetAlpha))
+	repoName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	deployKeyLabel := "TF ACC Test Deploy Key"
+	deployKeyPublicSSHKey := "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDAK/b1cHHDr/TEV1JGQl+WjCwStKG6Bhrv0rFpEsYlyTBm1fzN0VOJJYn4ZOPCPJwqse6fGbXntEs+BbXiptR+++HycVgl65TMR0b5ul5AgwrVdZdT7qjCOCgaSV74/9xlHDK8oqgGnfA7ZoBBU+qpVyaloSjBdJfLtPY/xqj4yHnXKYzrtn/uFc4Kp9Tb7PUg9Io3qohSTGJGVHnsVblq/rToJG7L5xIo0OxK0SJSQ5vuId93ZuFZrCNMXj8JDHZeSEtjJzpRCBEXHxpOPhAcbm4MzULgkFHhAVgp4JbkrT99/wpvZ7r9AdkTg7HGqL3rlaDrEcWfL7Lu6TnhBdq5"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_project" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  name       = "%s"
+					  key        = "%s"
+					  is_private = true
+					}
+
+					resource "bitbucket_repository" "testacc" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  project_key = bitbucket_project.testacc.key
+					  name        = "%s"
+					}
+
+					resource "bitbucket_deploy_key" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.name
+					  label      = "%s"
+					  key        = "%s"
+					}
+
+					data "bitbucket_deploy_keys" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.name
+
+					  depends_on = [bitbucket_deploy_key.testacc]
+					}`, workspaceSlug, projectName, projectKey, repoName, deployKeyLabel, deployKeyPublicSSHKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bitbucket_deploy_keys.testacc", "id", fmt.Sprintf("%s/%s", workspaceSlug, repoName)),
+					resource.TestCheckResourceAttr("data.bitbucket_deploy_keys.testacc", "workspace", workspaceSlug),
+					resource.TestCheckResourceAttr("data.bitbucket_deploy_keys.testacc", "repository", repoName),
+
+					resource.TestCheckResourceAttr("data.bitbucket_deploy_keys.testacc", "deploy_keys.#", "1"),
+					resource.TestCheckResourceAttrPair("data.bitbucket_deploy_keys.testacc", "deploy_keys.0.id", "bitbucket_deploy_key.testacc", "id"),
+					resource.TestCheckResourceAttr("data.bitbucket_deploy_keys.testacc", "deploy_keys.0.label", deployKeyLabel),
+					resource.TestCheckResourceAttr("data.bitbucket_deploy_keys.testacc", "deploy_keys.0.key", deployKeyPublicSSHKey),
+				),
+			},
+		},
+	})
+}
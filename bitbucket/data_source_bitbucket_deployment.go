@@ -1,17 +1,25 @@
 package bitbucket
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
 )
 
 func dataSourceBitbucketDeployment() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: resourceBitbucketDeploymentRead,
+		ReadContext: dataSourceBitbucketDeploymentRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Description: "The ID of the deployment.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "The ID of the deployment.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
 			},
 			"workspace": {
 				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
@@ -25,9 +33,11 @@ func dataSourceBitbucketDeployment() *schema.Resource {
 				ValidateDiagFunc: validateRepositoryName,
 			},
 			"name": {
-				Description: "The name of the deployment environment.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description:  "The name of the deployment environment.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
 			},
 			"environment": {
 				Description: "The environment of the deployment (will be one of 'Test', 'Staging', or 'Production').",
@@ -37,3 +47,29 @@ func dataSourceBitbucketDeployment() *schema.Resource {
 		},
 	}
 }
+
+func dataSourceBitbucketDeploymentRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if resourceData.Get("id").(string) == "" {
+		environments, err := meta.(*Clients).V2Ext.Environments.List(
+			&v2.EnvironmentOptions{
+				Owner:    resourceData.Get("workspace").(string),
+				RepoSlug: resourceData.Get("repository").(string),
+			},
+		)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("unable to get deployment environments with error: %s", err))
+		}
+
+		name := resourceData.Get("name").(string)
+		environment, err := findOne(environments, fmt.Sprintf("deployment environments named %q", name), func(environment v2.Environment) bool {
+			return environment.Name == name
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_ = resourceData.Set("id", environment.UUID)
+	}
+
+	return resourceBitbucketDeploymentRead(ctx, resourceData, meta)
+}
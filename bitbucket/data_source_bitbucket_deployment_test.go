@@ -52,6 +52,12 @@ func TestAccBitbucketDeploymentDataSource_basic(t *testing.T) {
 					  id         = bitbucket_deployment.testacc.id
 					  workspace  = data.bitbucket_workspace.testacc.id
 					  repository = bitbucket_repository.testacc.name
+					}
+	
+					data "bitbucket_deployment" "by_name" {
+					  name       = bitbucket_deployment.testacc.name
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.name
 					}`, workspaceSlug, projectName, projectKey, repoName, deploymentName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.bitbucket_deployment.testacc", "workspace", workspaceSlug),
@@ -60,6 +66,8 @@ func TestAccBitbucketDeploymentDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.bitbucket_deployment.testacc", "environment", "Test"),
 
 					resource.TestCheckResourceAttrSet("data.bitbucket_deployment.testacc", "id"),
+
+					resource.TestCheckResourceAttrPair("data.bitbucket_deployment.by_name", "id", "data.bitbucket_deployment.testacc", "id"),
 				),
 			},
 			{
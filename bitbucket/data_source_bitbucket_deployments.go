@@ -0,0 +1,85 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+func dataSourceBitbucketDeployments() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBitbucketDeploymentsRead,
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"repository": {
+				Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateRepositoryName,
+			},
+			"deployments": {
+				Description: "List of Deployments.",
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The Deployment's UUID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The Deployment's name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"environment": {
+							Description: "The Deployment's environment (will be one of 'Test', 'Staging', or 'Production').",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBitbucketDeploymentsRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	workspace := resourceData.Get("workspace").(string)
+	repository := resourceData.Get("repository").(string)
+
+	environments, err := client.Environments.List(
+		&v2.EnvironmentOptions{
+			Owner:    workspace,
+			RepoSlug: repository,
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to get deployments with error: %s", err))
+	}
+
+	var deployments []interface{}
+	for _, environment := range environments {
+		deployments = append(deployments, map[string]interface{}{
+			"id":          environment.UUID,
+			"name":        environment.Name,
+			"environment": environment.EnvironmentType.Name,
+		})
+	}
+
+	resourceData.SetId(fmt.Sprintf("%s/%s", workspace, repository))
+	_ = resourceData.Set("deployments", deployments)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBitbucketDeploymentsDataSource_basic(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	projectName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectKey := strings.ToUpper(acctest.RandStringFromCharSet(3, acctest.CharSetAlpha))
+	repoName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	deploymentName := "TF ACC Test Deployment"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_project" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  name       = "%s"
+					  key        = "%s"
+					  is_private = true
+					}
+
+					resource "bitbucket_repository" "testacc" {
+					  workspace        = data.bitbucket_workspace.testacc.id
+					  project_key      = bitbucket_project.testacc.key
+					  name             = "%s"
+					  enable_pipelines = true
+					}
+
+					resource "bitbucket_deployment" "testacc" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  repository  = bitbucket_repository.testacc.name
+					  name        = "%s"
+					  environment = "Staging"
+					}
+
+					data "bitbucket_deployments" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.name
+
+					  depends_on = [bitbucket_deployment.testacc]
+					}`, workspaceSlug, projectName, projectKey, repoName, deploymentName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bitbucket_deployments.testacc", "id", fmt.Sprintf("%s/%s", workspaceSlug, repoName)),
+					resource.TestCheckResourceAttr("data.bitbucket_deployments.testacc", "workspace", workspaceSlug),
+					resource.TestCheckResourceAttr("data.bitbucket_deployments.testacc", "repository", repoName),
+
+					resource.TestCheckResourceAttrSet("data.bitbucket_deployments.testacc", "deployments.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.bitbucket_deployments.testacc", "deployments.*", map[string]string{
+						"name":        deploymentName,
+						"environment": "Staging",
+					}),
+					resource.TestCheckTypeSetElemAttrPair("data.bitbucket_deployments.testacc", "deployments.*.id", "bitbucket_deployment.testacc", "id"),
+				),
+			},
+		},
+	})
+}
@@ -14,10 +14,20 @@ func dataSourceBitbucketGroup() *schema.Resource {
 				Computed:    true,
 			},
 			"workspace": {
-				Description: "The UUID (including the enclosing `{}`) of the workspace this group belongs to.",
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace this group belongs to.",
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"workspace_slug": {
+				Description: "The slug of the workspace.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"workspace_uuid": {
+				Description: "The UUID (including the enclosing `{}`) of the workspace.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"name": {
 				Description: "The name of the group.",
 				Type:        schema.TypeString,
@@ -33,6 +43,16 @@ func dataSourceBitbucketGroup() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"auto_add": {
+				Description: "Whether new members of the workspace are automatically added to this group.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"email_forwarding_disabled": {
+				Description: "Whether emails sent to this group are no longer forwarded to its members.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -0,0 +1,114 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceBitbucketGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBitbucketGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace the groups belong to.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"include_members": {
+				Description: "Whether to look up the members of each group, this requires an additional API call per group.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"groups": {
+				Description: "List of Groups.",
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The Group's name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"slug": {
+							Description: "The Group's slug.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"permission": {
+							Description: "The global permission the Group has over all repositories (will be one of 'none', 'read', 'write', 'admin').",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"auto_add": {
+							Description: "A boolean to state if new workspace members are automatically added to the Group.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"email_forwarding_disabled": {
+							Description: "A boolean to state if emails sent to the Group are no longer forwarded to its members.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"members": {
+							Description: "A set of the Group's member UUIDs, only populated when `include_members` is set.",
+							Type:        schema.TypeSet,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBitbucketGroupsRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).Groups
+
+	workspace, err := meta.(*Clients).WorkspaceUuid(resourceData.Get("workspace").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	includeMembers := resourceData.Get("include_members").(bool)
+
+	groups, err := client.List(workspace)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to get groups with error: %s", err))
+	}
+
+	var groupList []interface{}
+	for _, group := range groups {
+		var members []interface{}
+		if includeMembers {
+			groupMembers, err := client.ListMembers(workspace, group.Slug)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("unable to get members of group %s with error: %s", group.Slug, err))
+			}
+
+			for _, groupMember := range groupMembers {
+				members = append(members, groupMember.UUID)
+			}
+		}
+
+		groupList = append(groupList, map[string]interface{}{
+			"name":                      group.Name,
+			"slug":                      group.Slug,
+			"permission":                group.Permission,
+			"auto_add":                  group.AutoAdd,
+			"email_forwarding_disabled": group.EmailForwardingDisabled,
+			"members":                   members,
+		})
+	}
+
+	_ = resourceData.Set("groups", groupList)
+	resourceData.SetId(resourceData.Get("workspace").(string))
+
+	return nil
+}
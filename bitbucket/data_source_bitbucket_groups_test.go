@@ -0,0 +1,64 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBitbucketGroupsDataSource_basic(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	user, _ := getCurrentUser()
+	groupName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					data "bitbucket_user" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_group" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.uuid
+					  name       = "%s"
+					  permission = "read"
+					}
+
+					resource "bitbucket_group_member" "testacc" {
+					  workspace = data.bitbucket_workspace.testacc.uuid
+					  group     = bitbucket_group.testacc.slug
+					  user      = data.bitbucket_user.testacc.id
+					}
+
+					data "bitbucket_groups" "testacc" {
+					  workspace       = data.bitbucket_workspace.testacc.uuid
+					  include_members = true
+
+					  depends_on = [bitbucket_group_member.testacc]
+					}`, workspaceSlug, user.Uuid, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bitbucket_groups.testacc", "workspace", user.Uuid),
+					resource.TestCheckResourceAttr("data.bitbucket_groups.testacc", "include_members", "true"),
+					resource.TestCheckResourceAttrSet("data.bitbucket_groups.testacc", "id"),
+
+					resource.TestCheckTypeSetElemNestedAttrs("data.bitbucket_groups.testacc", "groups.*", map[string]string{
+						"name":       groupName,
+						"slug":       groupName,
+						"permission": "read",
+						"members.#":  "1",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,77 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceBitbucketHookEvents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBitbucketHookEventsRead,
+		Schema: map[string]*schema.Schema{
+			"subject_type": {
+				Description:  "The type of webhook to list the events of. Must be one of 'repository', 'workspace'.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"repository", "workspace"}, false),
+			},
+			"events": {
+				Description: "List of events a webhook can subscribe to.",
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event": {
+							Description: "The event's identifier, as used by a webhook's `events`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"category": {
+							Description: "The category the event belongs to.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"label": {
+							Description: "The event's name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "The event's description.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBitbucketHookEventsRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	subjectType := resourceData.Get("subject_type").(string)
+
+	hookEvents, err := meta.(*Clients).HookEvents(subjectType)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to get hook events with error: %s", err))
+	}
+
+	var events []interface{}
+	for _, hookEvent := range hookEvents {
+		events = append(events, map[string]interface{}{
+			"event":       hookEvent.Event,
+			"category":    hookEvent.Category,
+			"label":       hookEvent.Label,
+			"description": hookEvent.Description,
+		})
+	}
+
+	resourceData.SetId(subjectType)
+	_ = resourceData.Set("events", events)
+
+	return nil
+}
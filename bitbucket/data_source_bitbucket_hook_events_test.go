@@ -0,0 +1,39 @@
+package bitbucket
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBitbucketHookEventsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "bitbucket_hook_events" "repository" {
+					  subject_type = "repository"
+					}
+
+					data "bitbucket_hook_events" "workspace" {
+					  subject_type = "workspace"
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bitbucket_hook_events.repository", "id", "repository"),
+					resource.TestCheckResourceAttrSet("data.bitbucket_hook_events.repository", "events.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.bitbucket_hook_events.repository", "events.*", map[string]string{
+						"event": "repo:push",
+					}),
+
+					resource.TestCheckResourceAttr("data.bitbucket_hook_events.workspace", "id", "workspace"),
+					resource.TestCheckResourceAttrSet("data.bitbucket_hook_events.workspace", "events.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.bitbucket_hook_events.workspace", "events.*", map[string]string{
+						"event": "repo:created",
+					}),
+				),
+			},
+		},
+	})
+}
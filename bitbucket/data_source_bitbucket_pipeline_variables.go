@@ -0,0 +1,91 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+func dataSourceBitbucketPipelineVariables() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBitbucketPipelineVariablesRead,
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"repository": {
+				Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateRepositoryName,
+			},
+			"variables": {
+				Description: "List of Pipeline Variables.",
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The Pipeline Variable's UUID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"key": {
+							Description: "The Pipeline Variable's name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"value": {
+							Description: "The Pipeline Variable's value (note: if this variable is marked 'secured', this attribute will be blank).",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"secured": {
+							Description: "Whether the Pipeline Variable is considered secure/sensitive.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBitbucketPipelineVariablesRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	workspace := resourceData.Get("workspace").(string)
+	repository := resourceData.Get("repository").(string)
+
+	pipelineVariables, err := client.PipelineVariables.List(
+		&v2.PipelineVariableOptions{
+			Owner:    workspace,
+			RepoSlug: repository,
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to get pipeline variables with error: %s", err))
+	}
+
+	var variables []interface{}
+	for _, pipelineVariable := range pipelineVariables {
+		variables = append(variables, map[string]interface{}{
+			"id":      pipelineVariable.UUID,
+			"key":     pipelineVariable.Key,
+			"value":   pipelineVariable.Value,
+			"secured": pipelineVariable.Secured,
+		})
+	}
+
+	resourceData.SetId(fmt.Sprintf("%s/%s", workspace, repository))
+	_ = resourceData.Set("variables", variables)
+
+	return nil
+}
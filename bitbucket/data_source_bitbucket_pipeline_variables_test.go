@@ -0,0 +1,84 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBitbucketPipelineVariablesDataSource_basic(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	projectName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectKey := strings.ToUpper(acctest.RandStringFromCharSet(3, acctest.CharSetAlpha))
+	repoName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_project" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  name       = "%s"
+					  key        = "%s"
+					  is_private = true
+					}
+
+					resource "bitbucket_repository" "testacc" {
+					  workspace        = data.bitbucket_workspace.testacc.id
+					  project_key      = bitbucket_project.testacc.key
+					  name             = "%s"
+					  enable_pipelines = true
+					}
+
+					resource "bitbucket_pipeline_variable" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.name
+					  key        = "TF_ACC_TEST_VARIABLE"
+					  value      = "tf-acc-test"
+					}
+
+					resource "bitbucket_pipeline_variable" "secured" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.name
+					  key        = "TF_ACC_TEST_SECURED_VARIABLE"
+					  value      = "tf-acc-test"
+					  secured    = true
+					}
+
+					data "bitbucket_pipeline_variables" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.name
+
+					  depends_on = [bitbucket_pipeline_variable.testacc, bitbucket_pipeline_variable.secured]
+					}`, workspaceSlug, projectName, projectKey, repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bitbucket_pipeline_variables.testacc", "id", fmt.Sprintf("%s/%s", workspaceSlug, repoName)),
+					resource.TestCheckResourceAttr("data.bitbucket_pipeline_variables.testacc", "workspace", workspaceSlug),
+					resource.TestCheckResourceAttr("data.bitbucket_pipeline_variables.testacc", "repository", repoName),
+
+					resource.TestCheckResourceAttr("data.bitbucket_pipeline_variables.testacc", "variables.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.bitbucket_pipeline_variables.testacc", "variables.*", map[string]string{
+						"key":     "TF_ACC_TEST_VARIABLE",
+						"value":   "tf-acc-test",
+						"secured": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.bitbucket_pipeline_variables.testacc", "variables.*", map[string]string{
+						"key":     "TF_ACC_TEST_SECURED_VARIABLE",
+						"value":   "",
+						"secured": "true",
+					}),
+				),
+			},
+		},
+	})
+}
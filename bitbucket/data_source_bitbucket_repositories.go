@@ -0,0 +1,151 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+func dataSourceBitbucketRepositories() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBitbucketRepositoriesRead,
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"project_key": {
+				Description:      "The key of the project to list the repositories of.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateProjectKey,
+			},
+			"query": {
+				Description: "A query, written in Bitbucket's query language (BBQL), to filter the repositories by.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"sort": {
+				Description: "The field to sort the repositories by, prefixed with '-' to sort in descending order.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"role": {
+				Description:  "Only list repositories the authenticated user has this role on. Must be one of 'member', 'contributor', 'admin', 'owner'.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"member", "contributor", "admin", "owner"}, false),
+			},
+			"repositories": {
+				Description: "List of Repositories.",
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The Repository's UUID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The Repository's name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"slug": {
+							Description: "The Repository's slug.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"project_key": {
+							Description: "The key of the Project the Repository belongs to.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"is_private": {
+							Description: "A boolean to state if the Repository is private or not.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"main_branch": {
+							Description: "The name of the Repository's main branch.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"language": {
+							Description: "The Repository's language.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBitbucketRepositoriesRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	query := buildRepositoriesQuery(resourceData.Get("project_key").(string), resourceData.Get("query").(string))
+
+	repositories, err := client.Repositories.List(
+		&v2.RepositoryListOptions{
+			Owner: resourceData.Get("workspace").(string),
+			Query: query,
+			Sort:  resourceData.Get("sort").(string),
+			Role:  resourceData.Get("role").(string),
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to get repositories with error: %s", err))
+	}
+
+	var flattenedRepositories []interface{}
+	for _, repository := range repositories {
+		flattenedRepository := map[string]interface{}{
+			"id":   repository.UUID,
+			"name": repository.Name,
+			"slug": repository.Slug,
+		}
+		if repository.Project != nil {
+			flattenedRepository["project_key"] = repository.Project.Key
+		}
+		if repository.IsPrivate != nil {
+			flattenedRepository["is_private"] = *repository.IsPrivate
+		}
+		if repository.Mainbranch != nil {
+			flattenedRepository["main_branch"] = repository.Mainbranch.Name
+		}
+		if repository.Language != nil {
+			flattenedRepository["language"] = *repository.Language
+		}
+
+		flattenedRepositories = append(flattenedRepositories, flattenedRepository)
+	}
+	_ = resourceData.Set("repositories", flattenedRepositories)
+
+	resourceData.SetId(resourceData.Get("workspace").(string))
+
+	return nil
+}
+
+// buildRepositoriesQuery combines the project filter with the given BBQL query, so both apply.
+func buildRepositoriesQuery(projectKey string, query string) string {
+	if projectKey == "" {
+		return query
+	}
+
+	projectQuery := fmt.Sprintf("project.key = \"%s\"", projectKey)
+	if query == "" {
+		return projectQuery
+	}
+
+	return fmt.Sprintf("%s AND (%s)", projectQuery, query)
+}
@@ -0,0 +1,86 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccBitbucketRepositoriesDataSource_basic(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	projectName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectKey := strings.ToUpper(acctest.RandStringFromCharSet(3, acctest.CharSetAlpha))
+	repoName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_project" "testacc" {
+					  workspace = data.bitbucket_workspace.testacc.id
+					  name      = "%s"
+					  key       = "%s"
+					}
+
+					resource "bitbucket_repository" "testacc_one" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  project_key = bitbucket_project.testacc.key
+					  name        = "%s-1"
+					  language    = "go"
+					}
+
+					resource "bitbucket_repository" "testacc_two" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  project_key = bitbucket_project.testacc.key
+					  name        = "%s-2"
+					}
+
+					data "bitbucket_repositories" "testacc" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  project_key = bitbucket_project.testacc.key
+					  sort        = "name"
+					  depends_on  = [bitbucket_repository.testacc_one, bitbucket_repository.testacc_two]
+					}
+
+					data "bitbucket_repositories" "testacc_query" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  project_key = bitbucket_project.testacc.key
+					  query       = "language = \"go\""
+					  depends_on  = [bitbucket_repository.testacc_one, bitbucket_repository.testacc_two]
+					}`, workspaceSlug, projectName, projectKey, repoName, repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bitbucket_repositories.testacc", "id", workspaceSlug),
+					resource.TestCheckResourceAttr("data.bitbucket_repositories.testacc", "repositories.#", "2"),
+					resource.TestCheckResourceAttrPair("data.bitbucket_repositories.testacc", "repositories.0.id", "bitbucket_repository.testacc_one", "id"),
+					resource.TestCheckResourceAttr("data.bitbucket_repositories.testacc", "repositories.0.name", repoName+"-1"),
+					resource.TestCheckResourceAttr("data.bitbucket_repositories.testacc", "repositories.0.slug", repoName+"-1"),
+					resource.TestCheckResourceAttr("data.bitbucket_repositories.testacc", "repositories.0.project_key", projectKey),
+					resource.TestCheckResourceAttr("data.bitbucket_repositories.testacc", "repositories.0.is_private", "true"),
+					resource.TestCheckResourceAttr("data.bitbucket_repositories.testacc", "repositories.0.language", "go"),
+					resource.TestCheckResourceAttr("data.bitbucket_repositories.testacc", "repositories.1.name", repoName+"-2"),
+
+					resource.TestCheckResourceAttr("data.bitbucket_repositories.testacc_query", "repositories.#", "1"),
+					resource.TestCheckResourceAttr("data.bitbucket_repositories.testacc_query", "repositories.0.name", repoName+"-1"),
+				),
+			},
+		},
+	})
+}
+
+func TestBuildRepositoriesQuery(t *testing.T) {
+	assert.Equal(t, "", buildRepositoriesQuery("", ""))
+	assert.Equal(t, `language = "go"`, buildRepositoriesQuery("", `language = "go"`))
+	assert.Equal(t, `project.key = "EXP"`, buildRepositoriesQuery("EXP", ""))
+	assert.Equal(t, `project.key = "EXP" AND (language = "go" OR language = "rust")`, buildRepositoriesQuery("EXP", `language = "go" OR language = "rust"`))
+}
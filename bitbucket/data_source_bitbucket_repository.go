@@ -19,11 +19,16 @@ func dataSourceBitbucketRepository() *schema.Resource {
 				Required:    true,
 			},
 			"name": {
-				Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
+				Description:      "The slug of the repository to look up (must consist of only lowercase ASCII letters, numbers, underscores and hyphens). Once read, this is set to the repository's name.",
 				Type:             schema.TypeString,
 				Required:         true,
 				ValidateDiagFunc: validateRepositoryName,
 			},
+			"slug": {
+				Description: "The slug of the repository, used to refer to it in URLs.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"project_key": {
 				Description: "The key of the project this repository belongs to.",
 				Type:        schema.TypeString,
@@ -44,6 +49,16 @@ func dataSourceBitbucketRepository() *schema.Resource {
 				Type:        schema.TypeBool,
 				Computed:    true,
 			},
+			"has_issues": {
+				Description: "A boolean to state if the repository includes an issue tracker or not.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"main_branch": {
+				Description: "The name of the repository's main branch.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"fork_policy": {
 				Description: "The name of the fork policy to apply to this repository.",
 				Type:        schema.TypeString,
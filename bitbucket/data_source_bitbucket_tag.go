@@ -0,0 +1,44 @@
+package bitbucket
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceBitbucketTag() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: resourceBitbucketTagRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the tag.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"workspace": {
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"repository": {
+				Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateRepositoryName,
+			},
+			"name": {
+				Description: "The name of the tag.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"message": {
+				Description: "The message of the tag.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"hash": {
+				Description: "The hash of the commit the tag points to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
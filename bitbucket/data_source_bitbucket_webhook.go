@@ -1,17 +1,26 @@
 package bitbucket
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
 )
 
 func dataSourceBitbucketWebhook() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: resourceBitbucketWebhookRead,
+		ReadContext: dataSourceBitbucketWebhookRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Description: "The UUID of the webhook.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:   "The UUID of the webhook.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name", "url"},
+				AtLeastOneOf:  []string{"id", "name", "url"},
 			},
 			"workspace": {
 				Description: "The slug or UUID (including the enclosing `{}`) of the workspace this webhook belongs to.",
@@ -25,18 +34,22 @@ func dataSourceBitbucketWebhook() *schema.Resource {
 				ValidateDiagFunc: validateRepositoryName,
 			},
 			"name": {
-				Description: "The name of the webhook.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description:  "The name of the webhook.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name", "url"},
 			},
 			"url": {
-				Description: "The url to configure the webhook with.",
-				Type:        schema.TypeString,
-				Computed:    true,
+				Description:  "The url to configure the webhook with.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name", "url"},
 			},
 			"events": {
-				Description: "A list of events that will trigger the webhook - see docs for a complete list.",
-				Type:        schema.TypeList,
+				Description: "A set of events that will trigger the webhook.",
+				Type:        schema.TypeSet,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -47,6 +60,54 @@ func dataSourceBitbucketWebhook() *schema.Resource {
 				Type:        schema.TypeBool,
 				Computed:    true,
 			},
+			"secret_set": {
+				Description: "A boolean to state if the webhook has a secret or not.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"skip_cert_verification": {
+				Description: "A boolean to state if the SSL certificate of the webhook's url is not verified.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
 		},
 	}
 }
+
+func dataSourceBitbucketWebhookRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if resourceData.Get("id").(string) == "" {
+		webhooks, err := meta.(*Clients).V2Ext.Webhooks.List(
+			&v2.WebhookOptions{
+				Owner:    resourceData.Get("workspace").(string),
+				RepoSlug: resourceData.Get("repository").(string),
+			},
+		)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("unable to get webhooks with error: %s", err))
+		}
+
+		webhook, err := findWebhook(webhooks, resourceData.Get("name").(string), resourceData.Get("url").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_ = resourceData.Set("id", webhook.UUID)
+	}
+
+	return resourceBitbucketWebhookRead(ctx, resourceData, meta)
+}
+
+// findWebhook returns the only webhook with the given name and/or url, ignoring whichever of the two is empty.
+func findWebhook(webhooks []v2.Webhook, name string, url string) (*v2.Webhook, error) {
+	description := "webhooks"
+	if name != "" {
+		description = fmt.Sprintf("%s named %q", description, name)
+	}
+	if url != "" {
+		description = fmt.Sprintf("%s with url %q", description, url)
+	}
+
+	return findOne(webhooks, description, func(webhook v2.Webhook) bool {
+		return (name == "" || webhook.Description == name) && (url == "" || webhook.URL == url)
+	})
+}
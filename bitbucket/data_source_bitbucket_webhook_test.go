@@ -8,6 +8,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
 )
 
 func TestAccBitbucketWebhookDataSource_basic(t *testing.T) {
@@ -54,6 +57,12 @@ func TestAccBitbucketWebhookDataSource_basic(t *testing.T) {
 					  id         = bitbucket_webhook.testacc.id
 					  workspace  = data.bitbucket_workspace.testacc.id
 					  repository = bitbucket_repository.testacc.name
+					}
+	
+					data "bitbucket_webhook" "by_url" {
+					  url        = bitbucket_webhook.testacc.url
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.name
 					}`, workspaceSlug, projectName, projectKey, repoName, webhookName, webhookUrl),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.bitbucket_webhook.testacc", "workspace", workspaceSlug),
@@ -61,14 +70,44 @@ func TestAccBitbucketWebhookDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.bitbucket_webhook.testacc", "name", webhookName),
 					resource.TestCheckResourceAttr("data.bitbucket_webhook.testacc", "url", webhookUrl),
 					resource.TestCheckResourceAttr("data.bitbucket_webhook.testacc", "is_active", "true"),
+					resource.TestCheckResourceAttr("data.bitbucket_webhook.testacc", "secret_set", "false"),
+					resource.TestCheckResourceAttr("data.bitbucket_webhook.testacc", "skip_cert_verification", "false"),
 
 					resource.TestCheckResourceAttr("data.bitbucket_webhook.testacc", "events.#", "2"),
-					resource.TestCheckResourceAttr("data.bitbucket_webhook.testacc", "events.0", "pullrequest:approved"),
-					resource.TestCheckResourceAttr("data.bitbucket_webhook.testacc", "events.1", "pullrequest:unapproved"),
+					resource.TestCheckTypeSetElemAttr("data.bitbucket_webhook.testacc", "events.*", "pullrequest:approved"),
+					resource.TestCheckTypeSetElemAttr("data.bitbucket_webhook.testacc", "events.*", "pullrequest:unapproved"),
 
 					resource.TestCheckResourceAttrSet("data.bitbucket_webhook.testacc", "id"),
+
+					resource.TestCheckResourceAttrPair("data.bitbucket_webhook.by_url", "id", "data.bitbucket_webhook.testacc", "id"),
 				),
 			},
 		},
 	})
 }
+
+func TestFindWebhook(t *testing.T) {
+	webhooks := []v2.Webhook{
+		{UUID: "{1}", Description: "CI", URL: "https://ci.example.com"},
+		{UUID: "{2}", Description: "CI", URL: "https://ci.example.org"},
+		{UUID: "{3}", Description: "Chat", URL: "https://chat.example.com"},
+	}
+
+	webhook, err := findWebhook(webhooks, "Chat", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "{3}", webhook.UUID)
+
+	webhook, err = findWebhook(webhooks, "", "https://ci.example.org")
+	assert.NoError(t, err)
+	assert.Equal(t, "{2}", webhook.UUID)
+
+	webhook, err = findWebhook(webhooks, "CI", "https://ci.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "{1}", webhook.UUID)
+
+	_, err = findWebhook(webhooks, "CI", "")
+	assert.EqualError(t, err, `found 2 webhooks named "CI", expected exactly one`)
+
+	_, err = findWebhook(webhooks, "Chat", "https://ci.example.com")
+	assert.EqualError(t, err, `no webhooks named "Chat" with url "https://ci.example.com" found`)
+}
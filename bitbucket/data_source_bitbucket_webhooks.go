@@ -0,0 +1,112 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+func dataSourceBitbucketWebhooks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBitbucketWebhooksRead,
+		Schema: map[string]*schema.Schema{
+			"workspace": {
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"repository": {
+				Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateRepositoryName,
+			},
+			"webhooks": {
+				Description: "List of Webhooks.",
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The Webhook's UUID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The Webhook's name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"url": {
+							Description: "The url the Webhook is configured with.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"events": {
+							Description: "A set of events that will trigger the Webhook.",
+							Type:        schema.TypeSet,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+						},
+						"is_active": {
+							Description: "A boolean to state if the Webhook is active or not.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"secret_set": {
+							Description: "A boolean to state if the Webhook has a secret or not.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"skip_cert_verification": {
+							Description: "A boolean to state if the SSL certificate of the Webhook's url is not verified.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBitbucketWebhooksRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	workspace := resourceData.Get("workspace").(string)
+	repository := resourceData.Get("repository").(string)
+
+	webhooks, err := client.Webhooks.List(
+		&v2.WebhookOptions{
+			Owner:    workspace,
+			RepoSlug: repository,
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to get webhooks with error: %s", err))
+	}
+
+	var flattenedWebhooks []interface{}
+	for _, webhook := range webhooks {
+		flattenedWebhooks = append(flattenedWebhooks, map[string]interface{}{
+			"id":                     webhook.UUID,
+			"name":                   webhook.Description,
+			"url":                    webhook.URL,
+			"events":                 webhook.Events,
+			"is_active":              webhook.Active,
+			"secret_set":             webhook.SecretSet,
+			"skip_cert_verification": webhook.SkipCertVerification,
+		})
+	}
+
+	resourceData.SetId(fmt.Sprintf("%s/%s", workspace, repository))
+	_ = resourceData.Set("webhooks", flattenedWebhooks)
+
+	return nil
+}
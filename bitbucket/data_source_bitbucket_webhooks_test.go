@@ -0,0 +1,75 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBitbucketWebhooksDataSource_basic(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	projectName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectKey := strings.ToUpper(acctest.RandStringFromCharSet(3, acctest.CharSetAlpha))
+	repoName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	webhookName := "TF ACC Test Webhook"
+	webhookUrl := "https://example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_project" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  name       = "%s"
+					  key        = "%s"
+					  is_private = true
+					}
+
+					resource "bitbucket_repository" "testacc" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  project_key = bitbucket_project.testacc.key
+					  name        = "%s"
+					}
+
+					resource "bitbucket_webhook" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.name
+					  name       = "%s"
+					  url        = "%s"
+					  events     = ["repo:push"]
+					  is_active  = true
+					}
+
+					data "bitbucket_webhooks" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.name
+
+					  depends_on = [bitbucket_webhook.testacc]
+					}`, workspaceSlug, projectName, projectKey, repoName, webhookName, webhookUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bitbucket_webhooks.testacc", "id", fmt.Sprintf("%s/%s", workspaceSlug, repoName)),
+					resource.TestCheckResourceAttr("data.bitbucket_webhooks.testacc", "workspace", workspaceSlug),
+					resource.TestCheckResourceAttr("data.bitbucket_webhooks.testacc", "repository", repoName),
+
+					resource.TestCheckResourceAttr("data.bitbucket_webhooks.testacc", "webhooks.#", "1"),
+					resource.TestCheckResourceAttrPair("data.bitbucket_webhooks.testacc", "webhooks.0.id", "bitbucket_webhook.testacc", "id"),
+					resource.TestCheckResourceAttr("data.bitbucket_webhooks.testacc", "webhooks.0.name", webhookName),
+					resource.TestCheckResourceAttr("data.bitbucket_webhooks.testacc", "webhooks.0.url", webhookUrl),
+					resource.TestCheckResourceAttr("data.bitbucket_webhooks.testacc", "webhooks.0.is_active", "true"),
+					resource.TestCheckResourceAttr("data.bitbucket_webhooks.testacc", "webhooks.0.events.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.bitbucket_webhooks.testacc", "webhooks.0.events.*", "repo:push"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,56 @@
+package bitbucket
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceBitbucketWorkspaceWebhook() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: resourceBitbucketWorkspaceWebhookRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The UUID of the webhook.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"workspace": {
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace this webhook belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name": {
+				Description: "The name of the webhook.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"url": {
+				Description: "The url to configure the webhook with.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"events": {
+				Description: "A set of events that will trigger the webhook, for any repository in the workspace.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"is_active": {
+				Description: "A boolean to state if the webhook is active or not.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"secret_set": {
+				Description: "A boolean to state if the webhook has a secret or not.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"skip_cert_verification": {
+				Description: "A boolean to state if the SSL certificate of the webhook's url is not verified.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
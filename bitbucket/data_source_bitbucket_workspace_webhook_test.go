@@ -0,0 +1,55 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBitbucketWorkspaceWebhookDataSource_basic(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	webhookName := "TF ACC Test Workspace Webhook"
+	webhookUrl := "https://example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_workspace_webhook" "testacc" {
+					  workspace = data.bitbucket_workspace.testacc.id
+					  name      = "%s"
+					  url       = "%s"
+					  events    = ["repo:created", "repo:push"]
+					  is_active = true
+					}
+
+					data "bitbucket_workspace_webhook" "testacc" {
+					  id        = bitbucket_workspace_webhook.testacc.id
+					  workspace = data.bitbucket_workspace.testacc.id
+					}`, workspaceSlug, webhookName, webhookUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.bitbucket_workspace_webhook.testacc", "workspace", workspaceSlug),
+					resource.TestCheckResourceAttr("data.bitbucket_workspace_webhook.testacc", "name", webhookName),
+					resource.TestCheckResourceAttr("data.bitbucket_workspace_webhook.testacc", "url", webhookUrl),
+					resource.TestCheckResourceAttr("data.bitbucket_workspace_webhook.testacc", "is_active", "true"),
+					resource.TestCheckResourceAttr("data.bitbucket_workspace_webhook.testacc", "secret_set", "false"),
+					resource.TestCheckResourceAttr("data.bitbucket_workspace_webhook.testacc", "skip_cert_verification", "false"),
+
+					resource.TestCheckResourceAttr("data.bitbucket_workspace_webhook.testacc", "events.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.bitbucket_workspace_webhook.testacc", "events.*", "repo:created"),
+					resource.TestCheckTypeSetElemAttr("data.bitbucket_workspace_webhook.testacc", "events.*", "repo:push"),
+
+					resource.TestCheckResourceAttrPair("data.bitbucket_workspace_webhook.testacc", "id", "bitbucket_workspace_webhook.testacc", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,162 @@
+package bitbucket
+
+import (
+	v1 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v1"
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+// groupService manages a workspace's groups & their members. Groups are managed through the deprecated, but public, 1.0
+// API by default, with the undocumented internal API having to be opted into (see the provider's `groups_api` argument)
+// until Bitbucket publishes a replacement. Workspaces are always addressed by their UUID, as the 1.0 API does not accept
+// slugs.
+type groupService interface {
+	List(workspace string) ([]v2.Group, error)
+	Get(workspace string, slug string) (*v2.Group, error)
+	Create(workspace string, group *v2.Group) (*v2.Group, error)
+	Update(workspace string, slug string, group *v2.Group) (*v2.Group, error)
+	Delete(workspace string, slug string) error
+
+	ListMembers(workspace string, slug string) ([]v2.GroupMember, error)
+	AddMember(workspace string, slug string, user string) error
+	RemoveMember(workspace string, slug string, user string) error
+}
+
+func newGroupService(api string, v1Client *v1.Client, v2Client *v2.Client) groupService {
+	if api == "2.0" {
+		return &v2GroupService{client: v2Client}
+	}
+
+	return &v1GroupService{client: v1Client}
+}
+
+type v2GroupService struct {
+	client *v2.Client
+}
+
+func (s *v2GroupService) List(workspace string) ([]v2.Group, error) {
+	return s.client.Groups.List(&v2.GroupOptions{Owner: workspace})
+}
+
+func (s *v2GroupService) Get(workspace string, slug string) (*v2.Group, error) {
+	return s.client.Groups.Get(&v2.GroupOptions{Owner: workspace, Slug: slug})
+}
+
+func (s *v2GroupService) Create(workspace string, group *v2.Group) (*v2.Group, error) {
+	return s.client.Groups.Create(&v2.GroupOptions{Owner: workspace, Group: group})
+}
+
+func (s *v2GroupService) Update(workspace string, slug string, group *v2.Group) (*v2.Group, error) {
+	return s.client.Groups.Update(&v2.GroupOptions{Owner: workspace, Slug: slug, Group: group})
+}
+
+func (s *v2GroupService) Delete(workspace string, slug string) error {
+	return s.client.Groups.Delete(&v2.GroupOptions{Owner: workspace, Slug: slug})
+}
+
+func (s *v2GroupService) ListMembers(workspace string, slug string) ([]v2.GroupMember, error) {
+	return s.client.Groups.ListMembers(&v2.GroupMemberOptions{Owner: workspace, Slug: slug})
+}
+
+func (s *v2GroupService) AddMember(workspace string, slug string, user string) error {
+	return s.client.Groups.AddMember(&v2.GroupMemberOptions{Owner: workspace, Slug: slug, UserUUID: user})
+}
+
+func (s *v2GroupService) RemoveMember(workspace string, slug string, user string) error {
+	return s.client.Groups.RemoveMember(&v2.GroupMemberOptions{Owner: workspace, Slug: slug, UserUUID: user})
+}
+
+type v1GroupService struct {
+	client *v1.Client
+}
+
+func (s *v1GroupService) List(workspace string) ([]v2.Group, error) {
+	groups, err := s.client.Groups.List(&v1.GroupOptions{OwnerUuid: workspace})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]v2.Group, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, *groupFromV1(&group))
+	}
+
+	return result, nil
+}
+
+func (s *v1GroupService) Get(workspace string, slug string) (*v2.Group, error) {
+	group, err := s.client.Groups.Get(&v1.GroupOptions{OwnerUuid: workspace, Slug: slug})
+	if err != nil {
+		return nil, err
+	}
+
+	return groupFromV1(group), nil
+}
+
+func (s *v1GroupService) Create(workspace string, group *v2.Group) (*v2.Group, error) {
+	created, err := s.client.Groups.Create(&v1.GroupOptions{OwnerUuid: workspace, Name: group.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	return groupFromV1(created), nil
+}
+
+func (s *v1GroupService) Update(workspace string, slug string, group *v2.Group) (*v2.Group, error) {
+	updated, err := s.client.Groups.Update(
+		&v1.GroupOptions{
+			OwnerUuid:               workspace,
+			Slug:                    slug,
+			Name:                    group.Name,
+			Permission:              group.Permission,
+			AutoAdd:                 group.AutoAdd,
+			EmailForwardingDisabled: group.EmailForwardingDisabled,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupFromV1(updated), nil
+}
+
+func (s *v1GroupService) Delete(workspace string, slug string) error {
+	return s.client.Groups.Delete(&v1.GroupOptions{OwnerUuid: workspace, Slug: slug})
+}
+
+func (s *v1GroupService) ListMembers(workspace string, slug string) ([]v2.GroupMember, error) {
+	members, err := s.client.GroupMembers.Get(&v1.GroupMemberOptions{OwnerUuid: workspace, Slug: slug})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]v2.GroupMember, 0, len(members))
+	for _, member := range members {
+		result = append(result, v2.GroupMember{
+			UUID:        member.UUID,
+			AccountID:   member.AccountID,
+			DisplayName: member.DisplayName,
+			Nickname:    member.Nickname,
+		})
+	}
+
+	return result, nil
+}
+
+func (s *v1GroupService) AddMember(workspace string, slug string, user string) error {
+	_, err := s.client.GroupMembers.Create(&v1.GroupMemberOptions{OwnerUuid: workspace, Slug: slug, UserUuid: user})
+	return err
+}
+
+func (s *v1GroupService) RemoveMember(workspace string, slug string, user string) error {
+	return s.client.GroupMembers.Delete(&v1.GroupMemberOptions{OwnerUuid: workspace, Slug: slug, UserUuid: user})
+}
+
+func groupFromV1(group *v1.Group) *v2.Group {
+	return &v2.Group{
+		Name:                    group.Name,
+		Slug:                    group.Slug,
+		Permission:              group.Permission,
+		AutoAdd:                 group.AutoAdd,
+		EmailForwardingDisabled: group.EmailForwardingDisabled,
+	}
+}
@@ -0,0 +1,45 @@
+package bitbucket
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v1"
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+func TestNewGroupService(t *testing.T) {
+	v1Client := v1.NewClient(&v1.Auth{})
+	v2Client := v2.NewClient(&v2.Auth{})
+
+	assert.Equal(t, &v1GroupService{client: v1Client}, newGroupService("1.0", v1Client, v2Client))
+	assert.Equal(t, &v2GroupService{client: v2Client}, newGroupService("2.0", v1Client, v2Client))
+}
+
+func TestProviderGroupsApiDefault(t *testing.T) {
+	t.Setenv("BITBUCKET_GROUPS_API", "")
+
+	api, err := Provider().Schema["groups_api"].DefaultValue()
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0", api)
+}
+
+func TestGroupFromV1(t *testing.T) {
+	group := &v1.Group{
+		Name:                    "Example Group",
+		Slug:                    "example-group",
+		Permission:              "read",
+		AutoAdd:                 true,
+		EmailForwardingDisabled: true,
+	}
+
+	expected := &v2.Group{
+		Name:                    "Example Group",
+		Slug:                    "example-group",
+		Permission:              "read",
+		AutoAdd:                 true,
+		EmailForwardingDisabled: true,
+	}
+	assert.Equal(t, expected, groupFromV1(group))
+}
@@ -0,0 +1,410 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	gobb "github.com/ktrysmt/go-bitbucket"
+	"github.com/zclconf/go-cty/cty"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+// importedResource is an object found in a workspace, along with the ID it is imported by & the arguments to configure
+// it with, in the order they are written in.
+type importedResource struct {
+	resourceType string
+	name         string
+	id           string
+	arguments    []importedArgument
+}
+
+type importedArgument struct {
+	name  string
+	value cty.Value
+}
+
+// importGenerator walks a workspace, collecting the resources to import by their type.
+type importGenerator struct {
+	clients   *Clients
+	workspace string
+	resources map[string][]*importedResource
+
+	// workspaceUuid is what the resources which only accept the workspace's UUID are configured & imported with.
+	workspaceUuid string
+}
+
+// GenerateImports walks the given workspace, & writes a file per type of resource into outputDir, in which every
+// object found has a resource block along with the import block which brings it under Terraform's management. The
+// provider is configured from the same environment variables as when it is run by Terraform.
+//
+// Only the arguments needed to identify each object, & its main settings, are written, so the generated configuration
+// should be reviewed with `terraform plan` before being applied.
+func GenerateImports(ctx context.Context, workspace string, outputDir string) error {
+	provider := Provider()
+	config := terraform.NewResourceConfigRaw(nil)
+	if diags := provider.Validate(config); diags.HasError() {
+		return fmt.Errorf("unable to configure provider with error: %s: %s", diags[0].Summary, diags[0].Detail)
+	}
+	if diags := provider.Configure(ctx, config); diags.HasError() {
+		return fmt.Errorf("unable to configure provider with error: %s: %s", diags[0].Summary, diags[0].Detail)
+	}
+
+	generator := &importGenerator{
+		clients:   provider.Meta().(*Clients),
+		workspace: workspace,
+		resources: make(map[string][]*importedResource),
+	}
+	if err := generator.walkWorkspace(); err != nil {
+		return err
+	}
+
+	return generator.write(outputDir)
+}
+
+func (g *importGenerator) walkWorkspace() error {
+	projects, err := g.clients.V2.Workspaces.Projects(g.workspace)
+	if err != nil {
+		return fmt.Errorf("unable to get projects with error: %s", err)
+	}
+	for _, project := range projects.Items {
+		g.add("bitbucket_project", fmt.Sprintf("%s/%s", g.workspace, project.Key), []string{project.Key},
+			importedArgument{"workspace", cty.StringVal(g.workspace)},
+			importedArgument{"key", cty.StringVal(project.Key)},
+			importedArgument{"name", cty.StringVal(project.Name)},
+			importedArgument{"description", cty.StringVal(project.Description)},
+			importedArgument{"is_private", cty.BoolVal(project.Is_private)},
+		)
+	}
+
+	g.workspaceUuid, err = g.clients.WorkspaceUuid(g.workspace)
+	if err != nil {
+		return err
+	}
+
+	groups, err := g.clients.Groups.List(g.workspaceUuid)
+	if err != nil {
+		return fmt.Errorf("unable to get groups with error: %s", err)
+	}
+	for _, group := range groups {
+		g.add("bitbucket_group", fmt.Sprintf("%s/%s", g.workspace, group.Slug), []string{group.Slug},
+			importedArgument{"workspace", cty.StringVal(g.workspace)},
+			importedArgument{"name", cty.StringVal(group.Name)},
+			importedArgument{"permission", cty.StringVal(group.Permission)},
+			importedArgument{"auto_add", cty.BoolVal(group.AutoAdd)},
+			importedArgument{"email_forwarding_disabled", cty.BoolVal(group.EmailForwardingDisabled)},
+		)
+	}
+
+	repositories, err := g.clients.V2Ext.Repositories.List(&v2.RepositoryListOptions{Owner: g.workspace})
+	if err != nil {
+		return fmt.Errorf("unable to get repositories with error: %s", err)
+	}
+	for _, repository := range repositories {
+		if err := g.walkRepository(repository); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *importGenerator) walkRepository(repository v2.Repository) error {
+	repositoryId := fmt.Sprintf("%s/%s", g.workspace, repository.Slug)
+
+	arguments := []importedArgument{
+		{"workspace", cty.StringVal(g.workspace)},
+		{"name", cty.StringVal(repository.Name)},
+	}
+	if repository.Project != nil {
+		arguments = append(arguments, importedArgument{"project_key", cty.StringVal(repository.Project.Key)})
+	}
+	if repository.Description != nil && *repository.Description != "" {
+		arguments = append(arguments, importedArgument{"description", cty.StringVal(*repository.Description)})
+	}
+	if repository.IsPrivate != nil {
+		arguments = append(arguments, importedArgument{"is_private", cty.BoolVal(*repository.IsPrivate)})
+	}
+	g.add("bitbucket_repository", repositoryId, []string{repository.Slug}, arguments...)
+
+	branchRestrictions, err := g.clients.V2Ext.BranchRestrictions.List(&v2.BranchRestrictionOptions{Owner: g.workspace, RepoSlug: repository.Slug})
+	if err != nil {
+		return fmt.Errorf("unable to get branch restrictions of %s with error: %s", repository.Slug, err)
+	}
+	for _, branchRestriction := range branchRestrictions {
+		arguments := []importedArgument{
+			{"workspace", cty.StringVal(g.workspace)},
+			{"repository", cty.StringVal(repository.Slug)},
+			{"kind", cty.StringVal(branchRestriction.Kind)},
+		}
+		if branchRestriction.BranchMatchKind == "branching_model" {
+			arguments = append(arguments,
+				importedArgument{"branch_match_kind", cty.StringVal(branchRestriction.BranchMatchKind)},
+				importedArgument{"branch_type", cty.StringVal(branchRestriction.BranchType)},
+			)
+		} else {
+			arguments = append(arguments, importedArgument{"pattern", cty.StringVal(branchRestriction.Pattern)})
+		}
+		if branchRestriction.Value != nil {
+			arguments = append(arguments, importedArgument{"value", cty.NumberIntVal(int64(*branchRestriction.Value))})
+		}
+		if users := flattenBranchRestrictionUsers(branchRestriction.Users); len(users) > 0 {
+			arguments = append(arguments, importedArgument{"users", stringSetVal(users)})
+		}
+		if groups := flattenBranchRestrictionGroups(branchRestriction.Groups); len(groups) > 0 {
+			arguments = append(arguments, importedArgument{"groups", stringSetVal(groups)})
+		}
+
+		id := strconv.Itoa(branchRestriction.ID)
+		g.add("bitbucket_branch_restriction", fmt.Sprintf("%s/%s", repositoryId, id), []string{repository.Slug, branchRestriction.Kind, id}, arguments...)
+	}
+
+	webhooks, err := g.clients.V2Ext.Webhooks.List(&v2.WebhookOptions{Owner: g.workspace, RepoSlug: repository.Slug})
+	if err != nil {
+		return fmt.Errorf("unable to get webhooks of %s with error: %s", repository.Slug, err)
+	}
+	for _, webhook := range webhooks {
+		events := make([]interface{}, 0, len(webhook.Events))
+		for _, event := range webhook.Events {
+			events = append(events, event)
+		}
+
+		g.add("bitbucket_webhook", fmt.Sprintf("%s/%s", repositoryId, webhook.UUID), []string{repository.Slug, webhook.Description},
+			importedArgument{"workspace", cty.StringVal(g.workspace)},
+			importedArgument{"repository", cty.StringVal(repository.Slug)},
+			importedArgument{"name", cty.StringVal(webhook.Description)},
+			importedArgument{"url", cty.StringVal(webhook.URL)},
+			importedArgument{"events", stringSetVal(events)},
+			importedArgument{"is_active", cty.BoolVal(webhook.Active)},
+			importedArgument{"skip_cert_verification", cty.BoolVal(webhook.SkipCertVerification)},
+		)
+	}
+
+	environments, err := g.clients.V2Ext.Environments.List(&v2.EnvironmentOptions{Owner: g.workspace, RepoSlug: repository.Slug})
+	if err != nil {
+		return fmt.Errorf("unable to get deployments of %s with error: %s", repository.Slug, err)
+	}
+	for _, environment := range environments {
+		g.add("bitbucket_deployment", fmt.Sprintf("%s/%s", repositoryId, environment.UUID), []string{repository.Slug, environment.Name},
+			importedArgument{"workspace", cty.StringVal(g.workspace)},
+			importedArgument{"repository", cty.StringVal(repository.Slug)},
+			importedArgument{"name", cty.StringVal(environment.Name)},
+			importedArgument{"environment", cty.StringVal(environment.EnvironmentType.Name)},
+		)
+
+		deploymentVariables, err := g.clients.V2.Repositories.Repository.ListDeploymentVariables(
+			&gobb.RepositoryDeploymentVariablesOptions{
+				Owner:       g.workspace,
+				RepoSlug:    repository.Slug,
+				Environment: &gobb.Environment{Uuid: environment.UUID},
+				Pagelen:     1000,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to get deployment variables of %s with error: %s", repository.Slug, err)
+		}
+		for _, deploymentVariable := range deploymentVariables.Variables {
+			g.addDeploymentVariable(repository.Slug, environment, deploymentVariable)
+		}
+	}
+
+	// Pipelines have to be enabled for a repository to have any variables, otherwise Bitbucket responds with a 404.
+	pipelineVariables, err := g.clients.V2Ext.PipelineVariables.List(&v2.PipelineVariableOptions{Owner: g.workspace, RepoSlug: repository.Slug})
+	if err != nil && !v2.IsNotFound(err) {
+		return fmt.Errorf("unable to get pipeline variables of %s with error: %s", repository.Slug, err)
+	}
+	for _, pipelineVariable := range pipelineVariables {
+		// Bitbucket never returns the value of a secured variable, so it is left empty to be filled in.
+		g.add("bitbucket_pipeline_variable", fmt.Sprintf("%s/%s", repositoryId, pipelineVariable.UUID), []string{repository.Slug, pipelineVariable.Key},
+			importedArgument{"workspace", cty.StringVal(g.workspace)},
+			importedArgument{"repository", cty.StringVal(repository.Slug)},
+			importedArgument{"key", cty.StringVal(pipelineVariable.Key)},
+			importedArgument{"value", cty.StringVal(pipelineVariable.Value)},
+			importedArgument{"secured", cty.BoolVal(pipelineVariable.Secured)},
+		)
+	}
+
+	deployKeys, err := g.clients.V2Ext.DeployKeys.List(&v2.DeployKeyOptions{Owner: g.workspace, RepoSlug: repository.Slug})
+	if err != nil {
+		return fmt.Errorf("unable to get deploy keys of %s with error: %s", repository.Slug, err)
+	}
+	for _, deployKey := range deployKeys {
+		id := strconv.Itoa(deployKey.ID)
+		g.add("bitbucket_deploy_key", fmt.Sprintf("%s/%s", repositoryId, id), []string{repository.Slug, deployKey.Label},
+			importedArgument{"workspace", cty.StringVal(g.workspace)},
+			importedArgument{"repository", cty.StringVal(repository.Slug)},
+			importedArgument{"label", cty.StringVal(deployKey.Label)},
+			importedArgument{"key", cty.StringVal(strings.TrimSpace(fmt.Sprintf("%s %s", deployKey.Key, deployKey.Comment)))},
+		)
+	}
+
+	repositoryOptions := &gobb.RepositoryOptions{Owner: g.workspace, RepoSlug: repository.Slug}
+
+	groupPermissions, err := g.clients.V2.Repositories.Repository.ListGroupPermissions(repositoryOptions)
+	if err != nil {
+		return fmt.Errorf("unable to get group permissions of %s with error: %s", repository.Slug, err)
+	}
+	for _, groupPermission := range groupPermissions.GroupPermissions {
+		g.addGroupPermission(repository.Slug, groupPermission)
+	}
+
+	userPermissions, err := g.clients.V2.Repositories.Repository.ListUserPermissions(repositoryOptions)
+	if err != nil {
+		return fmt.Errorf("unable to get user permissions of %s with error: %s", repository.Slug, err)
+	}
+	for _, userPermission := range userPermissions.UserPermissions {
+		g.addUserPermission(repository.Slug, userPermission)
+	}
+
+	return nil
+}
+
+// addDeploymentVariable records a deployment variable to import. Bitbucket never returns the value of a secured
+// variable, so it is left empty to be filled in.
+func (g *importGenerator) addDeploymentVariable(repositorySlug string, environment v2.Environment, deploymentVariable gobb.DeploymentVariable) {
+	g.add("bitbucket_deployment_variable",
+		fmt.Sprintf("%s/%s/%s/%s", g.workspace, repositorySlug, environment.UUID, deploymentVariable.Uuid),
+		[]string{repositorySlug, environment.Name, deploymentVariable.Key},
+		importedArgument{"workspace", cty.StringVal(g.workspace)},
+		importedArgument{"repository", cty.StringVal(repositorySlug)},
+		importedArgument{"deployment", cty.StringVal(environment.UUID)},
+		importedArgument{"key", cty.StringVal(deploymentVariable.Key)},
+		importedArgument{"value", cty.StringVal(deploymentVariable.Value)},
+		importedArgument{"secured", cty.BoolVal(deploymentVariable.Secured)},
+	)
+}
+
+// addGroupPermission records a group permission to import, which is addressed by the workspace's UUID rather than its
+// slug.
+func (g *importGenerator) addGroupPermission(repositorySlug string, groupPermission gobb.GroupPermission) {
+	g.add("bitbucket_group_permission",
+		fmt.Sprintf("%s/%s/%s", g.workspaceUuid, repositorySlug, groupPermission.Group.Slug),
+		[]string{repositorySlug, groupPermission.Group.Slug},
+		importedArgument{"workspace", cty.StringVal(g.workspaceUuid)},
+		importedArgument{"repository", cty.StringVal(repositorySlug)},
+		importedArgument{"group", cty.StringVal(groupPermission.Group.Slug)},
+		importedArgument{"permission", cty.StringVal(groupPermission.Permission)},
+	)
+}
+
+// addUserPermission records a user permission to import, which is addressed by the workspace's UUID rather than its
+// slug.
+func (g *importGenerator) addUserPermission(repositorySlug string, userPermission gobb.UserPermission) {
+	g.add("bitbucket_user_permission",
+		fmt.Sprintf("%s/%s/%s", g.workspaceUuid, repositorySlug, userPermission.User.Uuid),
+		[]string{repositorySlug, userPermission.User.Nickname},
+		importedArgument{"workspace", cty.StringVal(g.workspaceUuid)},
+		importedArgument{"repository", cty.StringVal(repositorySlug)},
+		importedArgument{"user", cty.StringVal(userPermission.User.Uuid)},
+		importedArgument{"permission", cty.StringVal(userPermission.Permission)},
+	)
+}
+
+// add records a resource to import, named after the given parts, with a numeric suffix should that name already be
+// taken by another resource of the same type.
+func (g *importGenerator) add(resourceType string, id string, nameParts []string, arguments ...importedArgument) {
+	name := resourceName(nameParts...)
+
+	taken := func(name string) bool {
+		for _, resource := range g.resources[resourceType] {
+			if resource.name == name {
+				return true
+			}
+		}
+		return false
+	}
+	for i := 2; taken(name); i++ {
+		name = fmt.Sprintf("%s_%d", resourceName(nameParts...), i)
+	}
+
+	g.resources[resourceType] = append(g.resources[resourceType], &importedResource{
+		resourceType: resourceType,
+		name:         name,
+		id:           id,
+		arguments:    arguments,
+	})
+}
+
+// write writes a file per type of resource, e.g. `bitbucket_repository.tf`, into the given directory.
+func (g *importGenerator) write(outputDir string) error {
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return err
+	}
+
+	resourceTypes := make([]string, 0, len(g.resources))
+	for resourceType := range g.resources {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	for _, resourceType := range resourceTypes {
+		path := filepath.Join(outputDir, fmt.Sprintf("%s.tf", resourceType))
+		if err := os.WriteFile(path, renderImportedResources(g.resources[resourceType]), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// renderImportedResources renders an import block followed by a resource block for each of the given resources.
+func renderImportedResources(resources []*importedResource) []byte {
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+
+	for i, resource := range resources {
+		if i > 0 {
+			body.AppendNewline()
+		}
+
+		importBody := body.AppendNewBlock("import", nil).Body()
+		importBody.SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: resource.resourceType},
+			hcl.TraverseAttr{Name: resource.name},
+		})
+		importBody.SetAttributeValue("id", cty.StringVal(resource.id))
+		body.AppendNewline()
+
+		resourceBody := body.AppendNewBlock("resource", []string{resource.resourceType, resource.name}).Body()
+		for _, argument := range resource.arguments {
+			resourceBody.SetAttributeValue(argument.name, argument.value)
+		}
+	}
+
+	return file.Bytes()
+}
+
+var invalidResourceNameCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// resourceName joins the given parts into a valid Terraform resource name, e.g. "my-repo" & "Production" become
+// "my_repo_production".
+func resourceName(parts ...string) string {
+	name := strings.Trim(invalidResourceNameCharacters.ReplaceAllString(strings.ToLower(strings.Join(parts, "_")), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+
+	return name
+}
+
+func stringSetVal(values []interface{}) cty.Value {
+	if len(values) == 0 {
+		return cty.SetValEmpty(cty.String)
+	}
+
+	elements := make([]cty.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, cty.StringVal(value.(string)))
+	}
+
+	return cty.SetVal(elements)
+}
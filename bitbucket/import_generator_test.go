@@ -0,0 +1,132 @@
+package bitbucket
+
+import (
+	"testing"
+
+	gobb "github.com/ktrysmt/go-bitbucket"
+	"github.com/stretchr/testify/assert"
+	"github.com/zclconf/go-cty/cty"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+func TestResourceName(t *testing.T) {
+	assert.Equal(t, "my_repo_production", resourceName("my-repo", "Production"))
+	assert.Equal(t, "my_repo_require_approvals_to_merge_12", resourceName("my.repo", "require_approvals_to_merge", "12"))
+	assert.Equal(t, "_123_repo", resourceName("123-repo"))
+	assert.Equal(t, "_", resourceName("!!!"))
+}
+
+func TestImportGeneratorAddNamesResourcesUniquely(t *testing.T) {
+	generator := &importGenerator{resources: make(map[string][]*importedResource)}
+
+	generator.add("bitbucket_deploy_key", "my-workspace/my-repo/1", []string{"my-repo", "ci"})
+	generator.add("bitbucket_deploy_key", "my-workspace/my-repo/2", []string{"my-repo", "ci"})
+	generator.add("bitbucket_webhook", "my-workspace/my-repo/{webhook-uuid}", []string{"my-repo", "ci"})
+
+	assert.Equal(t, "my_repo_ci", generator.resources["bitbucket_deploy_key"][0].name)
+	assert.Equal(t, "my_repo_ci_2", generator.resources["bitbucket_deploy_key"][1].name)
+	assert.Equal(t, "my_repo_ci", generator.resources["bitbucket_webhook"][0].name)
+}
+
+func TestImportGeneratorAddDeploymentVariable(t *testing.T) {
+	generator := &importGenerator{workspace: "my-workspace", resources: make(map[string][]*importedResource)}
+	environment := v2.Environment{UUID: "{environment-uuid}", Name: "Production"}
+
+	generator.addDeploymentVariable("my-repo", environment, gobb.DeploymentVariable{Uuid: "{plain-uuid}", Key: "PLAIN", Value: "visible"})
+	generator.addDeploymentVariable("my-repo", environment, gobb.DeploymentVariable{Uuid: "{secured-uuid}", Key: "SECURED", Secured: true})
+
+	resources := generator.resources["bitbucket_deployment_variable"]
+	assert.Len(t, resources, 2)
+
+	assert.Equal(t, "my_repo_production_plain", resources[0].name)
+	assert.Equal(t, "my-workspace/my-repo/{environment-uuid}/{plain-uuid}", resources[0].id)
+	assert.Equal(t, []importedArgument{
+		{"workspace", cty.StringVal("my-workspace")},
+		{"repository", cty.StringVal("my-repo")},
+		{"deployment", cty.StringVal("{environment-uuid}")},
+		{"key", cty.StringVal("PLAIN")},
+		{"value", cty.StringVal("visible")},
+		{"secured", cty.False},
+	}, resources[0].arguments)
+
+	assert.Equal(t, "my_repo_production_secured", resources[1].name)
+	assert.Contains(t, resources[1].arguments, importedArgument{"value", cty.StringVal("")})
+	assert.Contains(t, resources[1].arguments, importedArgument{"secured", cty.True})
+}
+
+func TestImportGeneratorAddPermissions(t *testing.T) {
+	generator := &importGenerator{
+		workspace:     "my-workspace",
+		workspaceUuid: "{workspace-uuid}",
+		resources:     make(map[string][]*importedResource),
+	}
+
+	generator.addGroupPermission("my-repo", gobb.GroupPermission{Group: gobb.Group{Slug: "developers"}, Permission: "write"})
+	generator.addUserPermission("my-repo", gobb.UserPermission{User: gobb.User{Uuid: "{user-uuid}", Nickname: "user"}, Permission: "admin"})
+
+	groupPermission := generator.resources["bitbucket_group_permission"][0]
+	assert.Equal(t, "my_repo_developers", groupPermission.name)
+	assert.Equal(t, "{workspace-uuid}/my-repo/developers", groupPermission.id)
+	assert.Equal(t, []importedArgument{
+		{"workspace", cty.StringVal("{workspace-uuid}")},
+		{"repository", cty.StringVal("my-repo")},
+		{"group", cty.StringVal("developers")},
+		{"permission", cty.StringVal("write")},
+	}, groupPermission.arguments)
+
+	userPermission := generator.resources["bitbucket_user_permission"][0]
+	assert.Equal(t, "my_repo_user", userPermission.name)
+	assert.Equal(t, "{workspace-uuid}/my-repo/{user-uuid}", userPermission.id)
+	assert.Equal(t, []importedArgument{
+		{"workspace", cty.StringVal("{workspace-uuid}")},
+		{"repository", cty.StringVal("my-repo")},
+		{"user", cty.StringVal("{user-uuid}")},
+		{"permission", cty.StringVal("admin")},
+	}, userPermission.arguments)
+}
+
+func TestRenderImportedResources(t *testing.T) {
+	resources := []*importedResource{
+		{
+			resourceType: "bitbucket_repository",
+			name:         "my_repo",
+			id:           "my-workspace/my-repo",
+			arguments: []importedArgument{
+				{"workspace", cty.StringVal("my-workspace")},
+				{"name", cty.StringVal("my-repo")},
+				{"is_private", cty.True},
+			},
+		},
+		{
+			resourceType: "bitbucket_webhook",
+			name:         "my_repo_ci",
+			id:           "my-workspace/my-repo/{webhook-uuid}",
+			arguments: []importedArgument{
+				{"events", stringSetVal([]interface{}{"repo:push"})},
+			},
+		},
+	}
+
+	expected := `import {
+  to = bitbucket_repository.my_repo
+  id = "my-workspace/my-repo"
+}
+
+resource "bitbucket_repository" "my_repo" {
+  workspace  = "my-workspace"
+  name       = "my-repo"
+  is_private = true
+}
+
+import {
+  to = bitbucket_webhook.my_repo_ci
+  id = "my-workspace/my-repo/{webhook-uuid}"
+}
+
+resource "bitbucket_webhook" "my_repo_ci" {
+  events = ["repo:push"]
+}
+`
+	assert.Equal(t, expected, string(renderImportedResources(resources)))
+}
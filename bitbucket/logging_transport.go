@@ -0,0 +1,177 @@
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// redacted replaces any credential or secured value which would otherwise be logged, matching how tflog masks values.
+const redacted = "***"
+
+// redactedHeaders are the headers which carry credentials.
+var redactedHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+
+// redactedFields are the fields of a request or response body which always hold a secret, e.g. a webhook's secret.
+var redactedFields = []string{"password", "secret"}
+
+// traceLogLevelEnvVars are the environment variables which can set the provider's log level, from most to least
+// specific.
+var traceLogLevelEnvVars = []string{"TF_LOG_PROVIDER_BITBUCKET", "TF_LOG_PROVIDER", "TF_LOG"}
+
+// loggingTransport logs every request made to Bitbucket, along with its response, through the provider's logger so
+// that it is controlled by TF_LOG_PROVIDER. The method, url, status, duration & Bitbucket's request ID are logged at
+// DEBUG, with the headers & bodies also being logged at TRACE, with credentials & secured values redacted. Bodies are
+// only read into memory to be logged when TRACE is enabled.
+//
+// Requests are logged with their own context, though most clients make their requests without one, in which case the
+// context the provider was configured with is used instead.
+type loggingTransport struct {
+	ctx       context.Context
+	transport http.RoundTripper
+	trace     bool
+}
+
+func newLoggingTransport(ctx context.Context, transport http.RoundTripper) *loggingTransport {
+	return &loggingTransport{ctx: ctx, transport: transport, trace: isTraceLogging()}
+}
+
+func (t *loggingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+	if ctx == context.Background() {
+		ctx = t.ctx
+	}
+
+	fields := map[string]interface{}{
+		"http_method": request.Method,
+		"http_url":    request.URL.String(),
+	}
+
+	if t.trace {
+		requestBody, err := readBody(&request.Body)
+		if err != nil {
+			return nil, err
+		}
+		tflog.Trace(ctx, "Sending request to Bitbucket", fields, map[string]interface{}{
+			"http_request_headers": redactHeaders(request.Header),
+			"http_request_body":    redactBody(requestBody),
+		})
+	}
+
+	start := time.Now()
+	response, err := t.transport.RoundTrip(request)
+	fields["http_duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		tflog.Debug(ctx, "Request to Bitbucket failed", fields, map[string]interface{}{"error": err.Error()})
+		return nil, err
+	}
+
+	fields["http_status_code"] = response.StatusCode
+	fields["bitbucket_request_id"] = response.Header.Get("X-Request-Id")
+	tflog.Debug(ctx, "Received response from Bitbucket", fields)
+
+	if t.trace {
+		responseBody, err := readBody(&response.Body)
+		if err != nil {
+			return nil, err
+		}
+		tflog.Trace(ctx, "Received response body from Bitbucket", fields, map[string]interface{}{
+			"http_response_headers": redactHeaders(response.Header),
+			"http_response_body":    redactBody(responseBody),
+		})
+	}
+
+	return response, nil
+}
+
+// isTraceLogging reports whether the provider's logs are at TRACE, tflog having no way of asking it. Terraform logs
+// everything as JSON at TRACE as well.
+func isTraceLogging() bool {
+	for _, envVar := range traceLogLevelEnvVars {
+		if level := os.Getenv(envVar); level != "" {
+			return strings.EqualFold(level, "TRACE") || strings.EqualFold(level, "JSON")
+		}
+	}
+
+	return false
+}
+
+// readBody reads the whole of the given body, replacing it with a copy so that it can still be read afterwards.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	content, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(content))
+
+	return content, nil
+}
+
+func redactHeaders(headers http.Header) map[string]string {
+	result := make(map[string]string, len(headers))
+	for name, values := range headers {
+		result[name] = strings.Join(values, ", ")
+	}
+
+	for _, name := range redactedHeaders {
+		if _, ok := result[http.CanonicalHeaderKey(name)]; ok {
+			result[http.CanonicalHeaderKey(name)] = redacted
+		}
+	}
+
+	return result
+}
+
+// redactBody returns the given body, with any secret fields & the values of secured variables redacted when it is
+// JSON. Any other body is returned as is, as the only others sent are the 1.0 API's forms which hold no secrets.
+func redactBody(body []byte) string {
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return string(body)
+	}
+
+	result, err := json.Marshal(redactValue(decoded))
+	if err != nil {
+		return string(body)
+	}
+
+	return string(result)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			value[key] = redactValue(field)
+		}
+		for _, key := range redactedFields {
+			if _, ok := value[key]; ok {
+				value[key] = redacted
+			}
+		}
+		// Pipeline & deployment variables hold their value in plain text, unless they are secured.
+		if secured, ok := value["secured"].(bool); ok && secured {
+			if _, ok := value["value"]; ok {
+				value["value"] = redacted
+			}
+		}
+	case []interface{}:
+		for i, element := range value {
+			value[i] = redactValue(element)
+		}
+	}
+
+	return value
+}
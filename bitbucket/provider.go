@@ -2,12 +2,18 @@ package bitbucket
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	gobb "github.com/ktrysmt/go-bitbucket"
 
 	v1 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v1"
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
 )
 
 func Provider() *schema.Provider {
@@ -25,44 +31,73 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("BITBUCKET_PASSWORD", nil),
 				Description: "Password to authenticate with Bitbucket.",
 			},
+			"groups_api": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("BITBUCKET_GROUPS_API", "1.0"),
+				ValidateFunc: validation.StringInSlice([]string{"1.0", "2.0"}, false),
+				Description:  "The API to manage groups through, either `1.0` (the deprecated, but public, groups endpoint) or `2.0` (the internal workspace group endpoints, which have to be opted into).",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
+			"bitbucket_branch":              dataSourceBitbucketBranch(),
 			"bitbucket_branch_restriction":  dataSourceBitbucketBranchRestriction(),
+			"bitbucket_branch_restrictions": dataSourceBitbucketBranchRestrictions(),
+			"bitbucket_commit_statuses":     dataSourceBitbucketCommitStatuses(),
 			"bitbucket_default_reviewer":    dataSourceBitbucketDefaultReviewer(),
+			"bitbucket_default_reviewers":   dataSourceBitbucketDefaultReviewers(),
 			"bitbucket_deploy_key":          dataSourceBitbucketDeployKey(),
+			"bitbucket_deploy_keys":         dataSourceBitbucketDeployKeys(),
 			"bitbucket_deployment":          dataSourceBitbucketDeployment(),
 			"bitbucket_deployment_variable": dataSourceBitbucketDeploymentVariable(),
+			"bitbucket_deployments":         dataSourceBitbucketDeployments(),
 			"bitbucket_group":               dataSourceBitbucketGroup(),
 			"bitbucket_group_permission":    dataSourceBitbucketGroupPermission(),
+			"bitbucket_groups":              dataSourceBitbucketGroups(),
+			"bitbucket_hook_events":         dataSourceBitbucketHookEvents(),
 			"bitbucket_ip_ranges":           dataSourceBitbucketIpRanges(),
 			"bitbucket_pipeline_variable":   dataSourceBitbucketPipelineVariable(),
+			"bitbucket_pipeline_variables":  dataSourceBitbucketPipelineVariables(),
 			"bitbucket_project":             dataSourceBitbucketProject(),
+			"bitbucket_repositories":        dataSourceBitbucketRepositories(),
 			"bitbucket_repository":          dataSourceBitbucketRepository(),
+			"bitbucket_tag":                 dataSourceBitbucketTag(),
 			"bitbucket_user":                dataSourceBitbucketUser(),
 			"bitbucket_user_permission":     dataSourceBitbucketUserPermission(),
 			"bitbucket_user_workspace":      dataSourceBitbucketUserWorkspace(),
 			"bitbucket_webhook":             dataSourceBitbucketWebhook(),
+			"bitbucket_webhooks":            dataSourceBitbucketWebhooks(),
 			"bitbucket_workspace":           dataSourceBitbucketWorkspace(),
 			"bitbucket_workspace_members":   dataSourceBitbucketWorkspaceMembers(),
 			"bitbucket_workspace_projects":  dataSourceBitbucketWorkspaceProjects(),
+			"bitbucket_workspace_webhook":   dataSourceBitbucketWorkspaceWebhook(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"bitbucket_branch_restriction":  resourceBitbucketBranchRestriction(),
-			"bitbucket_default_reviewer":    resourceBitbucketDefaultReviewer(),
-			"bitbucket_deploy_key":          resourceBitbucketDeployKey(),
-			"bitbucket_deployment":          resourceBitbucketDeployment(),
-			"bitbucket_deployment_variable": resourceBitbucketDeploymentVariable(),
-			"bitbucket_group":               resourceBitbucketGroup(),
-			"bitbucket_group_member":        resourceBitbucketGroupMember(),
-			"bitbucket_group_permission":    resourceBitbucketGroupPermission(),
-			"bitbucket_pipeline_key_pair":   resourceBitbucketPipelineKeyPair(),
-			"bitbucket_pipeline_variable":   resourceBitbucketPipelineVariable(),
-			"bitbucket_project":             resourceBitbucketProject(),
-			"bitbucket_repository":          resourceBitbucketRepository(),
-			"bitbucket_user_permission":     resourceBitbucketUserPermission(),
-			"bitbucket_webhook":             resourceBitbucketWebhook(),
+			"bitbucket_branch":                     resourceBitbucketBranch(),
+			"bitbucket_branch_protection":          resourceBitbucketBranchProtection(),
+			"bitbucket_branch_restriction":         resourceBitbucketBranchRestriction(),
+			"bitbucket_commit_status":              resourceBitbucketCommitStatus(),
+			"bitbucket_default_reviewer":           resourceBitbucketDefaultReviewer(),
+			"bitbucket_deploy_key":                 resourceBitbucketDeployKey(),
+			"bitbucket_deployment":                 resourceBitbucketDeployment(),
+			"bitbucket_deployment_variable":        resourceBitbucketDeploymentVariable(),
+			"bitbucket_group":                      resourceBitbucketGroup(),
+			"bitbucket_group_member":               resourceBitbucketGroupMember(),
+			"bitbucket_group_permission":           resourceBitbucketGroupPermission(),
+			"bitbucket_pipeline_key_pair":          resourceBitbucketPipelineKeyPair(),
+			"bitbucket_pipeline_variable":          resourceBitbucketPipelineVariable(),
+			"bitbucket_project":                    resourceBitbucketProject(),
+			"bitbucket_project_branching_model":    resourceBitbucketProjectBranchingModel(),
+			"bitbucket_repository":                 resourceBitbucketRepository(),
+			"bitbucket_repository_branching_model": resourceBitbucketRepositoryBranchingModel(),
+			"bitbucket_repository_file":            resourceBitbucketRepositoryFile(),
+			"bitbucket_repository_settings":        resourceBitbucketRepositorySettings(),
+			"bitbucket_tag":                        resourceBitbucketTag(),
+			"bitbucket_user_permission":            resourceBitbucketUserPermission(),
+			"bitbucket_webhook":                    resourceBitbucketWebhook(),
+			"bitbucket_workspace_webhook":          resourceBitbucketWorkspaceWebhook(),
 		},
 
 		ConfigureContextFunc: configureProvider,
@@ -72,6 +107,122 @@ func Provider() *schema.Provider {
 type Clients struct {
 	V1 *v1.Client
 	V2 *gobb.Client
+
+	// V2Ext covers the 2.0 API endpoints which go-bitbucket does not implement.
+	V2Ext *v2.Client
+
+	// Groups manages groups through whichever API the provider was configured to use.
+	Groups groupService
+
+	hookEventsMutex sync.Mutex
+	hookEvents      map[string][]v2.HookEvent
+
+	workspacesMutex sync.Mutex
+	workspaces      map[string]*gobb.Workspace
+}
+
+// HookEvents returns the events a webhook for the given subject type can subscribe to. Bitbucket is only asked for
+// them once per subject type, with the result being reused for the rest of the run.
+func (c *Clients) HookEvents(subjectType string) ([]v2.HookEvent, error) {
+	c.hookEventsMutex.Lock()
+	defer c.hookEventsMutex.Unlock()
+
+	if events, ok := c.hookEvents[subjectType]; ok {
+		return events, nil
+	}
+
+	events, err := c.V2Ext.HookEvents.List(subjectType)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.hookEvents == nil {
+		c.hookEvents = make(map[string][]v2.HookEvent)
+	}
+	c.hookEvents[subjectType] = events
+
+	return events, nil
+}
+
+// Workspace returns the workspace with the given slug or UUID. Each workspace is only looked up once, with the result
+// being reused for the rest of the run under both its slug and its UUID.
+func (c *Clients) Workspace(workspace string) (*gobb.Workspace, error) {
+	c.workspacesMutex.Lock()
+	defer c.workspacesMutex.Unlock()
+
+	if found, ok := c.workspaces[strings.ToLower(workspace)]; ok {
+		return found, nil
+	}
+
+	found, err := c.V2.Workspaces.Get(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.workspaces == nil {
+		c.workspaces = make(map[string]*gobb.Workspace)
+	}
+	c.workspaces[strings.ToLower(found.Slug)] = found
+	c.workspaces[strings.ToLower(found.UUID)] = found
+
+	return found, nil
+}
+
+// WorkspaceUuid returns the UUID (including the enclosing `{}`) of the workspace with the given slug or UUID, for the
+// endpoints which only accept a UUID.
+func (c *Clients) WorkspaceUuid(workspace string) (string, error) {
+	if strings.HasPrefix(workspace, "{") && strings.HasSuffix(workspace, "}") {
+		return workspace, nil
+	}
+
+	found, err := c.Workspace(workspace)
+	if err != nil {
+		return "", fmt.Errorf("unable to get workspace %s with error: %s", workspace, err)
+	}
+
+	return found.UUID, nil
+}
+
+// suppressEquivalentWorkspaceDiff suppresses the diff between a workspace's slug and its UUID, using the slug & UUID the
+// workspace was resolved to when the resource was last read.
+func suppressEquivalentWorkspaceDiff(k, old, new string, resourceData *schema.ResourceData) bool {
+	return isEquivalentWorkspace(old, new, resourceData.Get("workspace_slug").(string), resourceData.Get("workspace_uuid").(string))
+}
+
+func isEquivalentWorkspace(old string, new string, slug string, uuid string) bool {
+	if strings.EqualFold(old, new) {
+		return true
+	}
+	if slug == "" || uuid == "" {
+		return false
+	}
+
+	isWorkspace := func(value string) bool {
+		return strings.EqualFold(value, slug) || strings.EqualFold(value, uuid)
+	}
+
+	return isWorkspace(old) && isWorkspace(new)
+}
+
+// findOne returns the only one of the given items which matches, failing if none or several do, so that a data source
+// looked up by something other than its ID can never silently pick the wrong one. The description should be plural,
+// e.g. `deployments named "production"`.
+func findOne[T any](items []T, description string, matches func(T) bool) (*T, error) {
+	var found []T
+	for _, item := range items {
+		if matches(item) {
+			found = append(found, item)
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("no %s found", description)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("found %d %s, expected exactly one", len(found), description)
+	}
 }
 
 func configureProvider(ctx context.Context, resourceData *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -89,9 +240,28 @@ func configureProvider(ctx context.Context, resourceData *schema.ResourceData) (
 		},
 	)
 
+	v2ExtClient := v2.NewClient(
+		&v2.Auth{
+			Username: resourceData.Get("username").(string),
+			Password: resourceData.Get("password").(string),
+		},
+	)
+
+	// Every client shares the one HTTP client, so that all of their requests are logged the same way
+	httpClient := &http.Client{Transport: newLoggingTransport(ctx, http.DefaultTransport)}
+	client.HttpClient = httpClient
+	v1Client.HttpClient = httpClient
+	v2ExtClient.HttpClient = httpClient
+
 	clients := &Clients{
-		V1: v1Client,
-		V2: client,
+		V1:    v1Client,
+		V2:    client,
+		V2Ext: v2ExtClient,
+		Groups: newGroupService(
+			resourceData.Get("groups_api").(string),
+			v1Client,
+			v2ExtClient,
+		),
 	}
 
 	return clients, nil
@@ -1,11 +1,16 @@
 package bitbucket
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
 )
 
 var testAccProvider *schema.Provider
@@ -32,3 +37,55 @@ func testAccPreCheck(t *testing.T) {
 	password := os.Getenv("BITBUCKET_PASSWORD")
 	assert.NotEqual(t, "", password, "BITBUCKET_PASSWORD must be set for acceptance tests")
 }
+
+func TestFindOne(t *testing.T) {
+	items := []string{"a", "b", "b"}
+
+	found, err := findOne(items, `items named "a"`, func(item string) bool { return item == "a" })
+	assert.NoError(t, err)
+	assert.Equal(t, "a", *found)
+
+	_, err = findOne(items, `items named "b"`, func(item string) bool { return item == "b" })
+	assert.EqualError(t, err, `found 2 items named "b", expected exactly one`)
+
+	_, err = findOne(items, `items named "c"`, func(item string) bool { return item == "c" })
+	assert.EqualError(t, err, `no items named "c" found`)
+}
+
+func TestWorkspaceUuidReturnsUuidsAsIs(t *testing.T) {
+	clients := &Clients{}
+
+	uuid, err := clients.WorkspaceUuid("{my-workspace-uuid}")
+	assert.NoError(t, err)
+	assert.Equal(t, "{my-workspace-uuid}", uuid)
+}
+
+func TestIsEquivalentWorkspace(t *testing.T) {
+	assert.True(t, isEquivalentWorkspace("my-workspace", "my-workspace", "", ""))
+	assert.True(t, isEquivalentWorkspace("my-workspace", "My-Workspace", "", ""))
+	assert.False(t, isEquivalentWorkspace("my-workspace", "{my-workspace-uuid}", "", ""))
+
+	assert.True(t, isEquivalentWorkspace("my-workspace", "{my-workspace-uuid}", "my-workspace", "{my-workspace-uuid}"))
+	assert.True(t, isEquivalentWorkspace("{my-workspace-uuid}", "my-workspace", "my-workspace", "{my-workspace-uuid}"))
+	assert.False(t, isEquivalentWorkspace("my-workspace", "other-workspace", "my-workspace", "{my-workspace-uuid}"))
+	assert.False(t, isEquivalentWorkspace("{my-workspace-uuid}", "{other-workspace-uuid}", "my-workspace", "{my-workspace-uuid}"))
+}
+
+// newTestClients returns clients which send every request, whether to the 2.0 API or to the internal one, to the given
+// handler, failing the test on any request when no handler is given.
+func newTestClients(t *testing.T, handler http.HandlerFunc) *Clients {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if handler == nil {
+			assert.Fail(t, "unexpected request", request.URL.String())
+			return
+		}
+		handler(writer, request)
+	}))
+	t.Cleanup(server.Close)
+
+	client := v2.NewClient(&v2.Auth{})
+	client.ApiBaseUrl, _ = url.Parse(server.URL + "/2.0")
+	client.InternalApiBaseUrl, _ = url.Parse(server.URL + "/internal")
+
+	return &Clients{V2Ext: client, Groups: &v2GroupService{client: client}}
+}
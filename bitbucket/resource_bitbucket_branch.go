@@ -0,0 +1,157 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+func resourceBitbucketBranch() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBitbucketBranchCreate,
+		ReadContext:   resourceBitbucketBranchRead,
+		DeleteContext: resourceBitbucketBranchDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBitbucketBranchImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the branch.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"workspace": {
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"repository": {
+				Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateRepositoryName,
+			},
+			"name": {
+				Description: "The name of the branch.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"target": {
+				Description: "The commit hash, or name of an existing branch, to create the branch from.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"hash": {
+				Description: "The hash of the commit the branch currently points to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBitbucketBranchCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	_, err := client.Refs.CreateBranch(
+		&v2.RefOptions{
+			Owner:    resourceData.Get("workspace").(string),
+			RepoSlug: resourceData.Get("repository").(string),
+			Ref: &v2.Ref{
+				Name: resourceData.Get("name").(string),
+				Target: v2.RefTarget{
+					Hash: resourceData.Get("target").(string),
+				},
+			},
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to create branch with error: %s", err))
+	}
+
+	return resourceBitbucketBranchRead(ctx, resourceData, meta)
+}
+
+func resourceBitbucketBranchRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	branch, err := client.Refs.GetBranch(
+		&v2.RefOptions{
+			Owner:    resourceData.Get("workspace").(string),
+			RepoSlug: resourceData.Get("repository").(string),
+			Name:     resourceData.Get("name").(string),
+		},
+	)
+	if err != nil {
+		// If the branch has been deleted outside of Terraform, we remove it from state so that it will be re-created.
+		if v2.IsNotFound(err) && resourceData.Id() != "" {
+			resourceData.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(fmt.Errorf("unable to get branch with error: %s", err))
+	}
+
+	_ = resourceData.Set("hash", branch.Target.Hash)
+
+	resourceData.SetId(generateRefId(
+		resourceData.Get("workspace").(string),
+		resourceData.Get("repository").(string),
+		branch.Name,
+	))
+
+	return nil
+}
+
+func resourceBitbucketBranchDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	err := client.Refs.DeleteBranch(
+		&v2.RefOptions{
+			Owner:    resourceData.Get("workspace").(string),
+			RepoSlug: resourceData.Get("repository").(string),
+			Name:     resourceData.Get("name").(string),
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to delete branch with error: %s", err))
+	}
+
+	resourceData.SetId("")
+
+	return nil
+}
+
+func resourceBitbucketBranchImport(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ret := []*schema.ResourceData{resourceData}
+
+	splitID := strings.SplitN(resourceData.Id(), "/", 3)
+	if len(splitID) < 3 {
+		return ret, fmt.Errorf("invalid import ID. It must to be in this format \"<workspace-slug|workspace-uuid>/<repository-name>/<branch-name>\"")
+	}
+
+	_ = resourceData.Set("workspace", splitID[0])
+	_ = resourceData.Set("repository", splitID[1])
+	_ = resourceData.Set("name", splitID[2])
+
+	_ = resourceBitbucketBranchRead(ctx, resourceData, meta)
+
+	// The branch may have moved on since it was created, so the commit it now points to is the best target we have.
+	_ = resourceData.Set("target", resourceData.Get("hash").(string))
+
+	return ret, nil
+}
+
+// generateRefId returns the ID of a branch or tag. As their names may contain slashes, the name is always last.
+func generateRefId(workspace string, repository string, name string) string {
+	return fmt.Sprintf("%s/%s/%s", workspace, repository, name)
+}
@@ -0,0 +1,651 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+type branchProtectionAttribute struct {
+	Kind        string
+	Description string
+}
+
+// branchProtectionValueAttributes returns the attributes which, when set to a value greater than zero, create a
+// restriction of the given kind using that value. Each call returns a new map, so callers can't change another's.
+func branchProtectionValueAttributes() map[string]branchProtectionAttribute {
+	return map[string]branchProtectionAttribute{
+		"required_approvals": {
+			Kind:        "require_approvals_to_merge",
+			Description: "The minimum number of approvals before a pull request can be merged.",
+		},
+		"required_default_reviewer_approvals": {
+			Kind:        "require_default_reviewer_approvals_to_merge",
+			Description: "The minimum number of approvals from default reviewers before a pull request can be merged.",
+		},
+		"required_passing_builds": {
+			Kind:        "require_passing_builds_to_merge",
+			Description: "The minimum number of passing builds before a pull request can be merged.",
+		},
+		"maximum_commits_behind": {
+			Kind:        "require_commits_behind",
+			Description: "The maximum number of commits a pull request's source branch can be behind its destination branch before it can be merged.",
+		},
+	}
+}
+
+// branchProtectionFlagAttributes returns the attributes which, when true, create a restriction of the given kind.
+func branchProtectionFlagAttributes() map[string]branchProtectionAttribute {
+	return map[string]branchProtectionAttribute{
+		"deny_force_push": {
+			Kind:        "force",
+			Description: "A boolean to state if force pushes are prevented.",
+		},
+		"deny_delete": {
+			Kind:        "delete",
+			Description: "A boolean to state if deleting matching branches is prevented.",
+		},
+		"require_tasks_to_be_completed": {
+			Kind:        "require_tasks_to_be_completed",
+			Description: "A boolean to state if all tasks must be completed before a pull request can be merged.",
+		},
+		"require_no_changes_requested": {
+			Kind:        "require_no_changes_requested",
+			Description: "A boolean to state if a pull request with changes requested cannot be merged.",
+		},
+		"require_all_dependencies_merged": {
+			Kind:        "require_all_dependencies_merged",
+			Description: "A boolean to state if all of a pull request's dependencies must be merged before it can be merged.",
+		},
+		"enforce_merge_checks": {
+			Kind:        "enforce_merge_checks",
+			Description: "A boolean to state if merge checks are enforced, rather than just shown as warnings (requires a Premium plan).",
+		},
+		"allow_auto_merge_when_builds_pass": {
+			Kind:        "allow_auto_merge_when_builds_pass",
+			Description: "A boolean to state if pull requests can be automatically merged once their builds pass.",
+		},
+		"reset_pullrequest_approvals_on_change": {
+			Kind:        "reset_pullrequest_approvals_on_change",
+			Description: "A boolean to state if approvals are removed when a pull request's source branch changes.",
+		},
+		"smart_reset_pullrequest_approvals": {
+			Kind:        "smart_reset_pullrequest_approvals",
+			Description: "A boolean to state if approvals are only removed when a pull request's source branch changes its diff.",
+		},
+		"reset_pullrequest_changes_requested_on_change": {
+			Kind:        "reset_pullrequest_changes_requested_on_change",
+			Description: "A boolean to state if requested changes are removed when a pull request's source branch changes.",
+		},
+	}
+}
+
+// branchProtectionExemptionBlocks returns the blocks which, when present, create a restriction of the given kind that
+// only their users & groups are exempt from.
+func branchProtectionExemptionBlocks() map[string]branchProtectionAttribute {
+	return map[string]branchProtectionAttribute{
+		"push": {
+			Kind:        "push",
+			Description: "When present, only the users & groups listed can push to matching branches.",
+		},
+		"merge": {
+			Kind:        "restrict_merges",
+			Description: "When present, only the users & groups listed can merge pull requests into matching branches.",
+		},
+	}
+}
+
+func resourceBitbucketBranchProtection() *schema.Resource {
+	resourceSchema := map[string]*schema.Schema{
+		"id": {
+			Description: "The ID of the branch protection.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"workspace": {
+			Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"repository": {
+			Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateDiagFunc: validateRepositoryName,
+		},
+		"pattern": {
+			Description: "The pattern to match against branches this protection will apply to.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"restriction_ids": {
+			Description: "A map of the kind of each underlying branch restriction to its ID.",
+			Type:        schema.TypeMap,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Computed: true,
+		},
+		"duplicate_restriction_ids": {
+			Description: "The IDs of any further branch restrictions on the pattern of a kind it already has, which will be deleted.",
+			Type:        schema.TypeList,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Computed: true,
+		},
+		"user_aliases": {
+			Description: "A map of each username given in `push` or `merge` to the UUID Bitbucket resolved it to.",
+			Type:        schema.TypeMap,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Computed: true,
+		},
+		"group_aliases": {
+			Description: "A map of each group name given in `push` or `merge` to the slug Bitbucket resolved it to.",
+			Type:        schema.TypeMap,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Computed: true,
+		},
+	}
+
+	for attribute, protection := range branchProtectionValueAttributes() {
+		resourceSchema[attribute] = &schema.Schema{
+			Description:  protection.Description,
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		}
+	}
+
+	for attribute, protection := range branchProtectionFlagAttributes() {
+		resourceSchema[attribute] = &schema.Schema{
+			Description: protection.Description,
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		}
+	}
+
+	for block, protection := range branchProtectionExemptionBlocks() {
+		resourceSchema[block] = &schema.Schema{
+			Description: protection.Description,
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"users": {
+						Description: "A set of users (usernames or user's UUID) that are exempt from this restriction. Users are read back by their UUID.",
+						Type:        schema.TypeSet,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional:         true,
+						DiffSuppressFunc: suppressEquivalentBranchRestrictionExemptions("user_aliases"),
+					},
+					"groups": {
+						Description: "A set of groups (group slugs or names) that are exempt from this restriction. Group names are looked up & sent as the group's slug, & groups are read back by their slug.",
+						Type:        schema.TypeSet,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+						Optional:         true,
+						DiffSuppressFunc: suppressEquivalentBranchRestrictionExemptions("group_aliases"),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceBitbucketBranchProtectionPut,
+		ReadContext:   resourceBitbucketBranchProtectionRead,
+		UpdateContext: resourceBitbucketBranchProtectionPut,
+		DeleteContext: resourceBitbucketBranchProtectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBitbucketBranchProtectionImport,
+		},
+		CustomizeDiff: customdiff.All(
+			planBranchProtectionDuplicatesDeletion,
+			customdiff.ComputedIf("user_aliases", hasBranchProtectionExemptionsChange),
+			customdiff.ComputedIf("group_aliases", hasBranchProtectionExemptionsChange),
+		),
+		Schema: resourceSchema,
+	}
+}
+
+// resourceBitbucketBranchProtectionPut makes the branch restrictions on the pattern match the configuration exactly,
+// updating the ones which exist, creating the ones that are missing & deleting any others.
+func resourceBitbucketBranchProtectionPut(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	existing, err := listBranchProtectionRestrictions(resourceData, client)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to get branch restrictions with error: %s", err))
+	}
+	unmanaged := unmanagedBranchProtectionRestrictions(resourceData, existing)
+
+	groupSlugs, err := branchRestrictionGroupSlugs(
+		meta.(*Clients),
+		resourceData.Get("workspace").(string),
+		flattenBranchProtectionExemptions(resourceData, "groups"),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	desired := expandBranchProtection(resourceData, groupSlugs)
+	applied := make(map[string]*v2.BranchRestriction)
+
+	for _, kind := range sortedBranchProtectionKinds(desired) {
+		opts := &v2.BranchRestrictionOptions{
+			Owner:             resourceData.Get("workspace").(string),
+			RepoSlug:          resourceData.Get("repository").(string),
+			BranchRestriction: desired[kind],
+		}
+
+		if restrictions := existing[kind]; len(restrictions) > 0 {
+			opts.ID = strconv.Itoa(restrictions[0].ID)
+			existing[kind] = restrictions[1:]
+
+			applied[kind], err = client.BranchRestrictions.Update(opts)
+		} else {
+			applied[kind], err = client.BranchRestrictions.Create(opts)
+		}
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("unable to apply %s branch restriction with error: %s", kind, err))
+		}
+	}
+
+	for kind, restrictions := range existing {
+		for _, restriction := range restrictions {
+			err = client.BranchRestrictions.Delete(
+				&v2.BranchRestrictionOptions{
+					Owner:    resourceData.Get("workspace").(string),
+					RepoSlug: resourceData.Get("repository").(string),
+					ID:       strconv.Itoa(restriction.ID),
+				},
+			)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("unable to delete %s branch restriction with error: %s", kind, err))
+			}
+		}
+	}
+
+	resourceData.SetId(generateBranchProtectionId(
+		resourceData.Get("workspace").(string),
+		resourceData.Get("repository").(string),
+		resourceData.Get("pattern").(string),
+	))
+
+	// Every restriction left on the pattern is now one of those applied, so they are recorded before reading them back
+	// in order that none are reported as unmanaged.
+	restrictionIds := make(map[string]interface{})
+	for kind, restriction := range applied {
+		restrictionIds[kind] = strconv.Itoa(restriction.ID)
+	}
+	_ = resourceData.Set("restriction_ids", restrictionIds)
+	_ = resourceData.Set("duplicate_restriction_ids", nil)
+
+	setBranchProtectionAliases(resourceData, applied)
+
+	diags := resourceBitbucketBranchProtectionRead(ctx, resourceData, meta)
+	if len(unmanaged) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Replaced branch restrictions not managed by this branch protection",
+			Detail: fmt.Sprintf(
+				"The %s branch restrictions on the pattern %q were not created by this branch protection, so have been "+
+					"updated or deleted to match it. If they are managed by bitbucket_branch_restriction resources, those "+
+					"resources & this branch protection will keep undoing each other's changes.",
+				describeBranchRestrictions(unmanaged),
+				resourceData.Get("pattern").(string),
+			),
+		})
+	}
+
+	return diags
+}
+
+func resourceBitbucketBranchProtectionRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readBranchProtection(resourceData, meta, true)
+}
+
+// readBranchProtection reads the branch restrictions on the pattern into the resource, warning of any it did not
+// already manage when asked to.
+func readBranchProtection(resourceData *schema.ResourceData, meta interface{}, warnUnmanaged bool) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	existing, err := listBranchProtectionRestrictions(resourceData, client)
+	if err != nil {
+		// If the repository has been deleted outside of Terraform, its branch restrictions go with it, so we remove it
+		// from state so that it will be re-created.
+		if v2.IsNotFound(err) && resourceData.Id() != "" {
+			resourceData.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(fmt.Errorf("unable to get branch restrictions with error: %s", err))
+	}
+
+	var diags diag.Diagnostics
+	if unmanaged := unmanagedBranchProtectionRestrictions(resourceData, existing); warnUnmanaged && len(unmanaged) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Found branch restrictions not managed by this branch protection",
+			Detail: fmt.Sprintf(
+				"The %s branch restrictions on the pattern %q were not created by this branch protection, so will be "+
+					"updated or deleted to match it when it is next applied. If they are managed by "+
+					"bitbucket_branch_restriction resources, those resources & this branch protection will keep undoing "+
+					"each other's changes.",
+				describeBranchRestrictions(unmanaged),
+				resourceData.Get("pattern").(string),
+			),
+		})
+	}
+
+	// A protection which only uses the defaults has no restrictions, so it is kept in state even when none exist.
+	restrictionIds := make(map[string]interface{})
+	var duplicateRestrictionIds []string
+	for _, kind := range sortedBranchRestrictionKinds(existing) {
+		restrictionIds[kind] = strconv.Itoa(existing[kind][0].ID)
+		for _, duplicate := range existing[kind][1:] {
+			duplicateRestrictionIds = append(duplicateRestrictionIds, strconv.Itoa(duplicate.ID))
+		}
+	}
+	_ = resourceData.Set("restriction_ids", restrictionIds)
+	_ = resourceData.Set("duplicate_restriction_ids", duplicateRestrictionIds)
+
+	for attribute, protection := range branchProtectionValueAttributes() {
+		value := 0
+		if restrictions := existing[protection.Kind]; len(restrictions) > 0 && restrictions[0].Value != nil {
+			value = *restrictions[0].Value
+		}
+		_ = resourceData.Set(attribute, value)
+	}
+
+	for attribute, protection := range branchProtectionFlagAttributes() {
+		_ = resourceData.Set(attribute, len(existing[protection.Kind]) > 0)
+	}
+
+	// The aliases are rebuilt against the users & groups read back, so that those no longer exempt stop being treated as
+	// equivalent to the configured values they were resolved from.
+	read := make(map[string]*v2.BranchRestriction)
+	for kind, restrictions := range existing {
+		read[kind] = &restrictions[0]
+	}
+	setBranchProtectionAliases(resourceData, read)
+
+	for block, protection := range branchProtectionExemptionBlocks() {
+		restrictions := existing[protection.Kind]
+		if len(restrictions) == 0 {
+			_ = resourceData.Set(block, nil)
+			continue
+		}
+
+		_ = resourceData.Set(block, []interface{}{
+			map[string]interface{}{
+				"users":  flattenBranchRestrictionUsers(restrictions[0].Users),
+				"groups": flattenBranchRestrictionGroups(restrictions[0].Groups),
+			},
+		})
+	}
+
+	return diags
+}
+
+func resourceBitbucketBranchProtectionDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	existing, err := listBranchProtectionRestrictions(resourceData, client)
+	if err != nil {
+		if v2.IsNotFound(err) {
+			resourceData.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(fmt.Errorf("unable to get branch restrictions with error: %s", err))
+	}
+
+	for kind, restrictions := range existing {
+		for _, restriction := range restrictions {
+			err = client.BranchRestrictions.Delete(
+				&v2.BranchRestrictionOptions{
+					Owner:    resourceData.Get("workspace").(string),
+					RepoSlug: resourceData.Get("repository").(string),
+					ID:       strconv.Itoa(restriction.ID),
+				},
+			)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("unable to delete %s branch restriction with error: %s", kind, err))
+			}
+		}
+	}
+
+	resourceData.SetId("")
+
+	return nil
+}
+
+func resourceBitbucketBranchProtectionImport(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ret := []*schema.ResourceData{resourceData}
+
+	splitID := strings.SplitN(resourceData.Id(), "/", 3)
+	if len(splitID) < 3 {
+		return ret, fmt.Errorf("invalid import ID. It must to be in this format \"<workspace-slug|workspace-uuid>/<repository-name>/<pattern>\"")
+	}
+
+	_ = resourceData.Set("workspace", splitID[0])
+	_ = resourceData.Set("repository", splitID[1])
+	_ = resourceData.Set("pattern", splitID[2])
+
+	// The restrictions on the pattern are being brought under the protection's management, so aren't warned about.
+	_ = readBranchProtection(resourceData, meta, false)
+
+	return ret, nil
+}
+
+// listBranchProtectionRestrictions returns every branch restriction on the resource's pattern, grouped by kind.
+func listBranchProtectionRestrictions(resourceData *schema.ResourceData, client *v2.Client) (map[string][]v2.BranchRestriction, error) {
+	branchRestrictions, err := client.BranchRestrictions.List(
+		&v2.BranchRestrictionOptions{
+			Owner:    resourceData.Get("workspace").(string),
+			RepoSlug: resourceData.Get("repository").(string),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	restrictions := make(map[string][]v2.BranchRestriction)
+	for _, branchRestriction := range branchRestrictions {
+		if branchRestriction.BranchMatchKind == "glob" && branchRestriction.Pattern == resourceData.Get("pattern").(string) {
+			restrictions[branchRestriction.Kind] = append(restrictions[branchRestriction.Kind], branchRestriction)
+		}
+	}
+
+	return restrictions, nil
+}
+
+// unmanagedBranchProtectionRestrictions returns those of the given restrictions, sorted by ID, which aren't recorded
+// in the resource's restriction IDs or duplicate restriction IDs.
+func unmanagedBranchProtectionRestrictions(resourceData *schema.ResourceData, existing map[string][]v2.BranchRestriction) []v2.BranchRestriction {
+	managed := make(map[string]bool)
+	for _, id := range resourceData.Get("restriction_ids").(map[string]interface{}) {
+		managed[id.(string)] = true
+	}
+	for _, id := range resourceData.Get("duplicate_restriction_ids").([]interface{}) {
+		managed[id.(string)] = true
+	}
+
+	var unmanaged []v2.BranchRestriction
+	for _, restrictions := range existing {
+		for _, restriction := range restrictions {
+			if !managed[strconv.Itoa(restriction.ID)] {
+				unmanaged = append(unmanaged, restriction)
+			}
+		}
+	}
+	sort.Slice(unmanaged, func(i, j int) bool { return unmanaged[i].ID < unmanaged[j].ID })
+
+	return unmanaged
+}
+
+// describeBranchRestrictions lists the kind & ID of each of the given restrictions, e.g. `push (123), delete (456)`.
+func describeBranchRestrictions(restrictions []v2.BranchRestriction) string {
+	var descriptions []string
+	for _, restriction := range restrictions {
+		descriptions = append(descriptions, fmt.Sprintf("%s (%d)", restriction.Kind, restriction.ID))
+	}
+
+	return strings.Join(descriptions, ", ")
+}
+
+// expandBranchProtection returns the branch restriction for each kind the configuration requires.
+func expandBranchProtection(resourceData *schema.ResourceData, groupSlugs map[string]string) map[string]*v2.BranchRestriction {
+	newRestriction := func(kind string) *v2.BranchRestriction {
+		return &v2.BranchRestriction{
+			Kind:            kind,
+			BranchMatchKind: "glob",
+			Pattern:         resourceData.Get("pattern").(string),
+			Users:           []v2.BranchRestrictionUser{},
+			Groups:          []v2.BranchRestrictionGroup{},
+		}
+	}
+
+	restrictions := make(map[string]*v2.BranchRestriction)
+
+	for attribute, protection := range branchProtectionValueAttributes() {
+		value := resourceData.Get(attribute).(int)
+		if value > 0 {
+			restrictions[protection.Kind] = newRestriction(protection.Kind)
+			restrictions[protection.Kind].Value = &value
+		}
+	}
+
+	for attribute, protection := range branchProtectionFlagAttributes() {
+		if resourceData.Get(attribute).(bool) {
+			restrictions[protection.Kind] = newRestriction(protection.Kind)
+		}
+	}
+
+	for block, protection := range branchProtectionExemptionBlocks() {
+		configured := resourceData.Get(block).([]interface{})
+		if len(configured) == 0 {
+			continue
+		}
+
+		restriction := newRestriction(protection.Kind)
+		if configured[0] != nil {
+			exemptions := configured[0].(map[string]interface{})
+			restriction.Users, restriction.Groups = expandBranchRestrictionExemptions(
+				exemptions["users"].(*schema.Set).List(),
+				exemptions["groups"].(*schema.Set).List(),
+				groupSlugs,
+			)
+		}
+		restrictions[protection.Kind] = restriction
+	}
+
+	return restrictions
+}
+
+// flattenBranchProtectionExemptions returns the exempt users or groups, as given by key, of every exemption block.
+func flattenBranchProtectionExemptions(resourceData *schema.ResourceData, key string) []interface{} {
+	var exemptions []interface{}
+	for block := range branchProtectionExemptionBlocks() {
+		if configured := resourceData.Get(block).([]interface{}); len(configured) > 0 && configured[0] != nil {
+			exemptions = append(exemptions, configured[0].(map[string]interface{})[key].(*schema.Set).List()...)
+		}
+	}
+
+	return exemptions
+}
+
+// setBranchProtectionAliases records the aliases of the users & groups exempt from the given restrictions, keyed by
+// kind, against those of the exemption blocks.
+func setBranchProtectionAliases(resourceData *schema.ResourceData, restrictions map[string]*v2.BranchRestriction) {
+	var users []v2.BranchRestrictionUser
+	var groups []v2.BranchRestrictionGroup
+	for _, protection := range branchProtectionExemptionBlocks() {
+		if restriction, ok := restrictions[protection.Kind]; ok {
+			users = append(users, restriction.Users...)
+			groups = append(groups, restriction.Groups...)
+		}
+	}
+
+	setBranchRestrictionAliases(
+		resourceData,
+		flattenBranchProtectionExemptions(resourceData, "users"),
+		flattenBranchProtectionExemptions(resourceData, "groups"),
+		users,
+		groups,
+	)
+}
+
+// planBranchProtectionDuplicatesDeletion plans the deletion of any duplicate restrictions found on the pattern, as
+// only the first of each kind is read back, so they would otherwise go unnoticed.
+func planBranchProtectionDuplicatesDeletion(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if len(diff.Get("duplicate_restriction_ids").([]interface{})) == 0 {
+		return nil
+	}
+
+	return diff.SetNew("duplicate_restriction_ids", []string{})
+}
+
+func hasBranchProtectionExemptionsChange(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+	for block := range branchProtectionExemptionBlocks() {
+		current, configured := diff.GetChange(block)
+		if len(current.([]interface{})) != len(configured.([]interface{})) {
+			return true
+		}
+
+		if hasBranchRestrictionExemptionsChange(diff, block+".0.users", "user_aliases") ||
+			hasBranchRestrictionExemptionsChange(diff, block+".0.groups", "group_aliases") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortedBranchRestrictionKinds(restrictions map[string][]v2.BranchRestriction) []string {
+	var kinds []string
+	for kind := range restrictions {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	return kinds
+}
+
+func sortedBranchProtectionKinds(restrictions map[string]*v2.BranchRestriction) []string {
+	var kinds []string
+	for kind := range restrictions {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	return kinds
+}
+
+func generateBranchProtectionId(workspace string, repository string, pattern string) string {
+	return fmt.Sprintf("%s/%s/%s", workspace, repository, pattern)
+}
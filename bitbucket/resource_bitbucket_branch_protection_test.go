@@ -0,0 +1,331 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+func TestAccBitbucketBranchProtectionResource_basic(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	projectName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectKey := strings.ToUpper(acctest.RandStringFromCharSet(3, acctest.CharSetAlpha))
+	repoName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	branchPattern := "release/*"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_project" "testacc" {
+					  workspace = data.bitbucket_workspace.testacc.id
+					  name      = "%s"
+					  key       = "%s"
+					}
+
+					resource "bitbucket_repository" "testacc" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  project_key = bitbucket_project.testacc.key
+					  name        = "%s"
+					}
+
+					resource "bitbucket_branch_protection" "testacc" {
+					  workspace               = data.bitbucket_workspace.testacc.id
+					  repository              = bitbucket_repository.testacc.name
+					  pattern                 = "%s"
+					  required_approvals      = 2
+					  required_passing_builds = 1
+					  deny_force_push         = true
+					  deny_delete             = true
+
+					  push {
+					    users = ["%s"]
+					  }
+					}`, workspaceSlug, projectName, projectKey, repoName, branchPattern, workspaceSlug),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "id", fmt.Sprintf("%s/%s/%s", workspaceSlug, repoName, branchPattern)),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "workspace", workspaceSlug),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "repository", repoName),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "pattern", branchPattern),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "required_approvals", "2"),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "required_passing_builds", "1"),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "deny_force_push", "true"),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "deny_delete", "true"),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "require_tasks_to_be_completed", "false"),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "push.#", "1"),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "push.0.users.#", "1"),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "user_aliases.%", "1"),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "merge.#", "0"),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "restriction_ids.%", "5"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_project" "testacc" {
+					  workspace = data.bitbucket_workspace.testacc.id
+					  name      = "%s"
+					  key       = "%s"
+					}
+
+					resource "bitbucket_repository" "testacc" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  project_key = bitbucket_project.testacc.key
+					  name        = "%s"
+					}
+
+					resource "bitbucket_branch_protection" "testacc" {
+					  workspace                     = data.bitbucket_workspace.testacc.id
+					  repository                    = bitbucket_repository.testacc.name
+					  pattern                       = "%s"
+					  required_approvals            = 1
+					  require_tasks_to_be_completed = true
+					}`, workspaceSlug, projectName, projectKey, repoName, branchPattern),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "required_approvals", "1"),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "required_passing_builds", "0"),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "deny_force_push", "false"),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "deny_delete", "false"),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "require_tasks_to_be_completed", "true"),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "push.#", "0"),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "restriction_ids.%", "2"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_project" "testacc" {
+					  workspace = data.bitbucket_workspace.testacc.id
+					  name      = "%s"
+					  key       = "%s"
+					}
+
+					resource "bitbucket_repository" "testacc" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  project_key = bitbucket_project.testacc.key
+					  name        = "%s"
+					}
+
+					resource "bitbucket_branch_protection" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.name
+					  pattern    = "%s"
+					}`, workspaceSlug, projectName, projectKey, repoName, branchPattern),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "id", fmt.Sprintf("%s/%s/%s", workspaceSlug, repoName, branchPattern)),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "required_approvals", "0"),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "require_tasks_to_be_completed", "false"),
+					resource.TestCheckResourceAttr("bitbucket_branch_protection.testacc", "restriction_ids.%", "0"),
+				),
+			},
+			{
+				ResourceName:      "bitbucket_branch_protection.testacc",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s/%s", workspaceSlug, repoName, branchPattern),
+				ImportStateVerify: true,
+				// Aliases are only recorded when Terraform applies the users & groups it's given
+				ImportStateVerifyIgnore: []string{"user_aliases", "group_aliases"},
+			},
+		},
+	})
+}
+
+func TestExpandBranchProtection(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBitbucketBranchProtection().Schema, map[string]interface{}{
+		"workspace":          "workspace",
+		"repository":         "repository",
+		"pattern":            "main",
+		"required_approvals": 2,
+		"deny_delete":        true,
+		"push": []interface{}{
+			map[string]interface{}{
+				"users":  []interface{}{"{user-uuid}"},
+				"groups": []interface{}{"admins"},
+			},
+		},
+	})
+
+	restrictions := expandBranchProtection(resourceData, nil)
+
+	assert.Equal(t, []string{"delete", "push", "require_approvals_to_merge"}, sortedBranchProtectionKinds(restrictions))
+	assert.Equal(t, 2, *restrictions["require_approvals_to_merge"].Value)
+	assert.Nil(t, restrictions["delete"].Value)
+	assert.Equal(t, "main", restrictions["push"].Pattern)
+	assert.Equal(t, "{user-uuid}", restrictions["push"].Users[0].UUID)
+	assert.Equal(t, "admins", restrictions["push"].Groups[0].Slug)
+}
+
+func TestResourceBitbucketBranchProtectionSuppressesEquivalentExemptions(t *testing.T) {
+	state := testBranchProtectionState(map[string]string{
+		"restriction_ids.%":    "1",
+		"restriction_ids.push": "123",
+		"push.#":               "1",
+		"push.0.users.#":       "1",
+		"push.0.users.0":       "{user-a-uuid}",
+		"push.0.groups.#":      "0",
+		"user_aliases.%":       "1",
+		"user_aliases.user-a":  "{user-a-uuid}",
+	})
+	config := func(user string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"workspace":  "workspace",
+			"repository": "repository",
+			"pattern":    "main",
+			"push": []interface{}{
+				map[string]interface{}{"users": []interface{}{user}},
+			},
+		})
+	}
+
+	diff, err := resourceBitbucketBranchProtection().Diff(context.Background(), state, config("user-a"), nil)
+	assert.NoError(t, err)
+	assert.Nil(t, diff)
+
+	diff, err = resourceBitbucketBranchProtection().Diff(context.Background(), state, config("user-b"), nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, diff)
+	assert.True(t, diff.Attributes["user_aliases.%"].NewComputed)
+}
+
+func TestResourceBitbucketBranchProtectionPlansDuplicatesDeletion(t *testing.T) {
+	state := testBranchProtectionState(map[string]string{
+		"restriction_ids.%":           "1",
+		"restriction_ids.delete":      "123",
+		"deny_delete":                 "true",
+		"duplicate_restriction_ids.#": "1",
+		"duplicate_restriction_ids.0": "456",
+	})
+
+	diff, err := resourceBitbucketBranchProtection().Diff(
+		context.Background(),
+		state,
+		terraform.NewResourceConfigRaw(map[string]interface{}{
+			"workspace":   "workspace",
+			"repository":  "repository",
+			"pattern":     "main",
+			"deny_delete": true,
+		}),
+		nil,
+	)
+	assert.NoError(t, err)
+	assert.NotNil(t, diff)
+	assert.Equal(t, "0", diff.Attributes["duplicate_restriction_ids.#"].New)
+	assert.True(t, diff.Attributes["duplicate_restriction_ids.0"].NewRemoved)
+}
+
+// testBranchProtectionState returns the state of a branch protection on the "main" pattern with no restrictions,
+// other than those given by the given attributes.
+func testBranchProtectionState(attributes map[string]string) *terraform.InstanceState {
+	state := &terraform.InstanceState{
+		ID: "workspace/repository/main",
+		Attributes: map[string]string{
+			"id":                          "workspace/repository/main",
+			"workspace":                   "workspace",
+			"repository":                  "repository",
+			"pattern":                     "main",
+			"restriction_ids.%":           "0",
+			"duplicate_restriction_ids.#": "0",
+			"user_aliases.%":              "0",
+			"group_aliases.%":             "0",
+			"push.#":                      "0",
+			"merge.#":                     "0",
+		},
+	}
+	for attribute := range branchProtectionValueAttributes() {
+		state.Attributes[attribute] = "0"
+	}
+	for attribute := range branchProtectionFlagAttributes() {
+		state.Attributes[attribute] = "false"
+	}
+	for attribute, value := range attributes {
+		state.Attributes[attribute] = value
+	}
+
+	return state
+}
+
+func TestResourceBitbucketBranchProtectionReadWarnsOfUnmanagedRestrictions(t *testing.T) {
+	clients := newTestClients(t, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, "/2.0/repositories/workspace/repository/branch-restrictions", request.URL.EscapedPath())
+
+		_, _ = writer.Write([]byte(`{"values": [
+			{"id": 123, "kind": "delete", "branch_match_kind": "glob", "pattern": "main"},
+			{"id": 456, "kind": "delete", "branch_match_kind": "glob", "pattern": "main"},
+			{"id": 789, "kind": "force", "branch_match_kind": "glob", "pattern": "main"},
+			{"id": 999, "kind": "force", "branch_match_kind": "glob", "pattern": "develop"}
+		]}`))
+	})
+	newResourceData := func() *schema.ResourceData {
+		resourceData := schema.TestResourceDataRaw(t, resourceBitbucketBranchProtection().Schema, map[string]interface{}{
+			"workspace":   "workspace",
+			"repository":  "repository",
+			"pattern":     "main",
+			"deny_delete": true,
+		})
+		resourceData.SetId("workspace/repository/main")
+		assert.NoError(t, resourceData.Set("restriction_ids", map[string]interface{}{"delete": "123"}))
+		assert.NoError(t, resourceData.Set("duplicate_restriction_ids", []interface{}{"456"}))
+
+		return resourceData
+	}
+
+	// The force restriction was created outside of the branch protection, e.g. by a bitbucket_branch_restriction
+	resourceData := newResourceData()
+	diags := resourceBitbucketBranchProtectionRead(context.Background(), resourceData, clients)
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Contains(t, diags[0].Detail, "The force (789) branch restrictions on the pattern \"main\"")
+	assert.Equal(t, map[string]interface{}{"delete": "123", "force": "789"}, resourceData.Get("restriction_ids"))
+	assert.True(t, resourceData.Get("deny_force_push").(bool))
+
+	// Importing brings every restriction on the pattern under the branch protection's management
+	diags = readBranchProtection(newResourceData(), clients, false)
+	assert.Empty(t, diags)
+}
+
+func TestUnmanagedBranchProtectionRestrictions(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBitbucketBranchProtection().Schema, map[string]interface{}{})
+	assert.NoError(t, resourceData.Set("restriction_ids", map[string]interface{}{"push": "1"}))
+	assert.NoError(t, resourceData.Set("duplicate_restriction_ids", []interface{}{"2"}))
+
+	existing := map[string][]v2.BranchRestriction{
+		"push":   {{ID: 1, Kind: "push"}, {ID: 2, Kind: "push"}, {ID: 4, Kind: "push"}},
+		"delete": {{ID: 3, Kind: "delete"}},
+	}
+
+	unmanaged := unmanagedBranchProtectionRestrictions(resourceData, existing)
+	assert.Equal(t, []v2.BranchRestriction{{ID: 3, Kind: "delete"}, {ID: 4, Kind: "push"}}, unmanaged)
+	assert.Equal(t, "delete (3), push (4)", describeBranchRestrictions(unmanaged))
+}
+
+func TestBranchProtectionAttributesAreCopied(t *testing.T) {
+	attributes := branchProtectionFlagAttributes()
+	delete(attributes, "deny_delete")
+
+	assert.Contains(t, branchProtectionFlagAttributes(), "deny_delete")
+}
@@ -6,10 +6,14 @@ import (
 	"strconv"
 	"strings"
 
+	"golang.org/x/exp/slices"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	gobb "github.com/ktrysmt/go-bitbucket"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
 )
 
 func resourceBitbucketBranchRestriction() *schema.Resource {
@@ -21,118 +25,182 @@ func resourceBitbucketBranchRestriction() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceBitbucketBranchRestrictionImport,
 		},
-		Schema: map[string]*schema.Schema{
-			"id": {
-				Description: "The ID of the branch restriction.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"workspace": {
-				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
-				Type:        schema.TypeString,
-				Required:    true,
-			},
-			"repository": {
-				Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
-				Type:             schema.TypeString,
-				Required:         true,
-				ValidateDiagFunc: validateRepositoryName,
-			},
-			"pattern": {
-				Description: "The pattern to match against branches this restriction will apply to.",
-				Type:        schema.TypeString,
-				Required:    true,
+		CustomizeDiff: customdiff.All(
+			validateBranchRestrictionBranchMatchKind,
+			validateBranchRestrictionExemptions,
+			customdiff.ComputedIf("user_aliases", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return hasBranchRestrictionExemptionsChange(diff, "users", "user_aliases")
+			}),
+			customdiff.ComputedIf("group_aliases", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return hasBranchRestrictionExemptionsChange(diff, "groups", "group_aliases")
+			}),
+		),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceBitbucketBranchRestrictionV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: upgradeStateListsToSets,
 			},
-			"kind": {
-				Description: "The type of restriction to apply.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ValidateFunc: validation.StringInSlice([]string{
-					"require_tasks_to_be_completed",
-					"allow_auto_merge_when_builds_pass",
-					"require_passing_builds_to_merge",
-					"force",
-					"require_all_dependencies_merged",
-					"require_commits_behind",
-					"restrict_merges",
-					"enforce_merge_checks",
-					"reset_pullrequest_changes_requested_on_change",
-					"require_no_changes_requested",
-					"smart_reset_pullrequest_approvals",
-					"push",
-					"require_approvals_to_merge",
-					"require_default_reviewer_approvals_to_merge",
-					"reset_pullrequest_approvals_on_change",
-					"delete",
-				}, false),
-				ForceNew: true,
+		},
+		Schema: resourceBitbucketBranchRestrictionSchema(),
+	}
+}
+
+func resourceBitbucketBranchRestrictionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: "The ID of the branch restriction.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"workspace": {
+			Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"repository": {
+			Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validateRepositoryName,
+		},
+		"branch_match_kind": {
+			Description:  "How branches this restriction will apply to are matched. Must be one of 'glob' (matches `pattern`) or 'branching_model' (matches `branch_type`).",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "glob",
+			ValidateFunc: validation.StringInSlice([]string{"glob", "branching_model"}, false),
+		},
+		"pattern": {
+			Description:  "The pattern to match against branches this restriction will apply to. Only used when `branch_match_kind` is 'glob'.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"pattern", "branch_type"},
+		},
+		"branch_type": {
+			Description:  "The branch type from the repository's branching model this restriction will apply to. Only used when `branch_match_kind` is 'branching_model'. Must be one of 'feature', 'bugfix', 'release', 'hotfix', 'development', 'production'.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"pattern", "branch_type"},
+			ValidateFunc: validation.StringInSlice([]string{"feature", "bugfix", "release", "hotfix", "development", "production"}, false),
+		},
+		"kind": {
+			Description: "The type of restriction to apply.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"require_tasks_to_be_completed",
+				"allow_auto_merge_when_builds_pass",
+				"require_passing_builds_to_merge",
+				"force",
+				"require_all_dependencies_merged",
+				"require_commits_behind",
+				"restrict_merges",
+				"enforce_merge_checks",
+				"reset_pullrequest_changes_requested_on_change",
+				"require_no_changes_requested",
+				"smart_reset_pullrequest_approvals",
+				"push",
+				"require_approvals_to_merge",
+				"require_default_reviewer_approvals_to_merge",
+				"reset_pullrequest_approvals_on_change",
+				"delete",
+			}, false),
+			ForceNew: true,
+		},
+		"value": {
+			Description: "A configurable value used by the following restrictions: `require_passing_builds_to_merge` uses it to define the number of minimum number of passing builds, `require_approvals_to_merge` uses it to define the minimum number of approvals before the PR can be merged, `require_default_reviewer_approvals_to_merge` uses it to define the minimum number of approvals from default reviewers before the PR can be merged.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Default:     0,
+		},
+		"users": {
+			Description: "A set of users (usernames or user's UUID) that are exempt from this branch restriction. Can only be set if restriction type (`kind`) is set to `push` or `restrict_merges`. Users are read back by their UUID.",
+			Type:        schema.TypeSet,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
 			},
-			"value": {
-				Description: "A configurable value used by the following restrictions: `require_passing_builds_to_merge` uses it to define the number of minimum number of passing builds, `require_approvals_to_merge` uses it to define the minimum number of approvals before the PR can be merged, `require_default_reviewer_approvals_to_merge` uses it to define the minimum number of approvals from default reviewers before the PR can be merged.",
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Default:     0,
+			Optional:         true,
+			DiffSuppressFunc: suppressEquivalentBranchRestrictionExemptions("user_aliases"),
+		},
+		"groups": {
+			Description: "A set of groups (group slugs or names) that are exempt from this branch restriction. Can only be set if restriction type (`kind`) is set to `push` or `restrict_merges`. Group names are looked up & sent as the group's slug, & groups are read back by their slug.",
+			Type:        schema.TypeSet,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
 			},
-			"users": {
-				Description: "A list of users (usernames or user's UUID) that are exempt from this branch restriction. Can only be set if restriction type (`kind`) is set to `push` or `restrict_merges`.",
-				Type:        schema.TypeList,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-				Optional: true,
+			Optional:         true,
+			DiffSuppressFunc: suppressEquivalentBranchRestrictionExemptions("group_aliases"),
+		},
+		"user_aliases": {
+			Description: "A map of each username given in `users` to the UUID Bitbucket resolved it to.",
+			Type:        schema.TypeMap,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
 			},
-			"groups": {
-				Description: "A list of groups (group names or group UUID) that are exempt from this branch restriction. Can only be set if restriction type (`kind`) is set to `push` or `restrict_merges`.",
-				Type:        schema.TypeList,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-				Optional: true,
+			Computed: true,
+		},
+		"group_aliases": {
+			Description: "A map of each group name given in `groups` to the slug Bitbucket resolved it to.",
+			Type:        schema.TypeMap,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
 			},
+			Computed: true,
 		},
 	}
 }
 
+// resourceBitbucketBranchRestrictionV0 is the resource as it was before `users` & `groups` became sets.
+func resourceBitbucketBranchRestrictionV0() *schema.Resource {
+	resourceSchema := resourceBitbucketBranchRestrictionSchema()
+	resourceSchema["users"].Type = schema.TypeList
+	resourceSchema["groups"].Type = schema.TypeList
+
+	return &schema.Resource{Schema: resourceSchema}
+}
+
 func resourceBitbucketBranchRestrictionCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).V2
+	client := meta.(*Clients).V2Ext
 
-	opts := &gobb.BranchRestrictionsOptions{
-		Owner:    resourceData.Get("workspace").(string),
-		RepoSlug: resourceData.Get("repository").(string),
-		Pattern:  resourceData.Get("pattern").(string),
-		Kind:     resourceData.Get("kind").(string),
-		Value:    nil,
-		Users:    nil,
-		Groups:   nil,
-	}
-	value := resourceData.Get("value").(int)
-	if value > 0 {
-		opts.Value = value
-	}
-	users := parseBranchRestrictionUserFields(resourceData.Get("users").([]interface{}))
-	if len(users) > 0 {
-		opts.Users = users
-	}
-	groups := parseBranchRestrictionUserGroupFields(resourceData.Get("groups").([]interface{}))
-	if len(groups) > 0 {
-		opts.Groups = groups
+	groupSlugs, err := branchRestrictionGroupSlugs(
+		meta.(*Clients),
+		resourceData.Get("workspace").(string),
+		resourceData.Get("groups").(*schema.Set).List(),
+	)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	branchRestriction, err := client.Repositories.BranchRestrictions.Create(opts)
+	branchRestriction, err := client.BranchRestrictions.Create(
+		&v2.BranchRestrictionOptions{
+			Owner:             resourceData.Get("workspace").(string),
+			RepoSlug:          resourceData.Get("repository").(string),
+			BranchRestriction: expandBranchRestriction(resourceData, groupSlugs),
+		},
+	)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to create branch restriction with error: %s", err))
 	}
 
 	resourceData.SetId(strconv.Itoa(branchRestriction.ID))
+	setBranchRestrictionAliases(
+		resourceData,
+		resourceData.Get("users").(*schema.Set).List(),
+		resourceData.Get("groups").(*schema.Set).List(),
+		branchRestriction.Users,
+		branchRestriction.Groups,
+	)
 
 	return resourceBitbucketBranchRestrictionRead(ctx, resourceData, meta)
 }
 
 func resourceBitbucketBranchRestrictionRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).V2
+	client := meta.(*Clients).V2Ext
 
-	branchRestriction, err := client.Repositories.BranchRestrictions.Get(
-		&gobb.BranchRestrictionsOptions{
+	branchRestriction, err := client.BranchRestrictions.Get(
+		&v2.BranchRestrictionOptions{
 			Owner:    resourceData.Get("workspace").(string),
 			RepoSlug: resourceData.Get("repository").(string),
 			ID:       resourceData.Get("id").(string),
@@ -142,7 +210,7 @@ func resourceBitbucketBranchRestrictionRead(ctx context.Context, resourceData *s
 		// Handles a case whereby if the branch restrictions were deleted after being provisioned, Bitbucket's API
 		// returns a 404, so we treat that as the item having been deleted, therefore Terraform will re-provision
 		// if necessary.
-		if err.Error() == "404 Not Found" {
+		if v2.IsNotFound(err) {
 			resourceData.SetId("")
 			return nil
 		}
@@ -150,54 +218,68 @@ func resourceBitbucketBranchRestrictionRead(ctx context.Context, resourceData *s
 		return diag.FromErr(fmt.Errorf("unable to get branch restriction with error: %s", err))
 	}
 
+	_ = resourceData.Set("branch_match_kind", branchRestriction.BranchMatchKind)
 	_ = resourceData.Set("pattern", branchRestriction.Pattern)
+	_ = resourceData.Set("branch_type", branchRestriction.BranchType)
 	_ = resourceData.Set("kind", branchRestriction.Kind)
 	_ = resourceData.Set("value", branchRestriction.Value)
 
+	// The aliases are rebuilt against the users & groups read back, so that those no longer exempt stop being treated as
+	// equivalent to the configured values they were resolved from.
+	setBranchRestrictionAliases(
+		resourceData,
+		resourceData.Get("users").(*schema.Set).List(),
+		resourceData.Get("groups").(*schema.Set).List(),
+		branchRestriction.Users,
+		branchRestriction.Groups,
+	)
+	_ = resourceData.Set("users", flattenBranchRestrictionUsers(branchRestriction.Users))
+	_ = resourceData.Set("groups", flattenBranchRestrictionGroups(branchRestriction.Groups))
+
 	resourceData.SetId(strconv.Itoa(branchRestriction.ID))
 
 	return nil
 }
 
 func resourceBitbucketBranchRestrictionUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).V2
-
-	opts := &gobb.BranchRestrictionsOptions{
-		Owner:    resourceData.Get("workspace").(string),
-		RepoSlug: resourceData.Get("repository").(string),
-		ID:       resourceData.Id(),
-		Pattern:  resourceData.Get("pattern").(string),
-		Kind:     resourceData.Get("kind").(string),
-		Value:    nil,
-		Users:    nil,
-		Groups:   nil,
-	}
-	value := resourceData.Get("value").(int)
-	if value > 0 {
-		opts.Value = value
-	}
-	users := parseBranchRestrictionUserFields(resourceData.Get("users").([]interface{}))
-	if len(users) > 0 {
-		opts.Users = users
-	}
-	groups := parseBranchRestrictionUserGroupFields(resourceData.Get("groups").([]interface{}))
-	if len(groups) > 0 {
-		opts.Groups = groups
+	client := meta.(*Clients).V2Ext
+
+	groupSlugs, err := branchRestrictionGroupSlugs(
+		meta.(*Clients),
+		resourceData.Get("workspace").(string),
+		resourceData.Get("groups").(*schema.Set).List(),
+	)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	_, err := client.Repositories.BranchRestrictions.Update(opts)
+	branchRestriction, err := client.BranchRestrictions.Update(
+		&v2.BranchRestrictionOptions{
+			Owner:             resourceData.Get("workspace").(string),
+			RepoSlug:          resourceData.Get("repository").(string),
+			ID:                resourceData.Id(),
+			BranchRestriction: expandBranchRestriction(resourceData, groupSlugs),
+		},
+	)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to update branch restriction with error: %s", err))
 	}
+	setBranchRestrictionAliases(
+		resourceData,
+		resourceData.Get("users").(*schema.Set).List(),
+		resourceData.Get("groups").(*schema.Set).List(),
+		branchRestriction.Users,
+		branchRestriction.Groups,
+	)
 
 	return resourceBitbucketBranchRestrictionRead(ctx, resourceData, meta)
 }
 
 func resourceBitbucketBranchRestrictionDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).V2
+	client := meta.(*Clients).V2Ext
 
-	_, err := client.Repositories.BranchRestrictions.Delete(
-		&gobb.BranchRestrictionsOptions{
+	err := client.BranchRestrictions.Delete(
+		&v2.BranchRestrictionOptions{
 			Owner:    resourceData.Get("workspace").(string),
 			RepoSlug: resourceData.Get("repository").(string),
 			ID:       resourceData.Id(),
@@ -229,6 +311,264 @@ func resourceBitbucketBranchRestrictionImport(ctx context.Context, resourceData
 	return ret, nil
 }
 
+func expandBranchRestriction(resourceData *schema.ResourceData, groupSlugs map[string]string) *v2.BranchRestriction {
+	branchRestriction := &v2.BranchRestriction{
+		Kind:            resourceData.Get("kind").(string),
+		BranchMatchKind: resourceData.Get("branch_match_kind").(string),
+	}
+
+	if branchRestriction.BranchMatchKind == "branching_model" {
+		branchRestriction.BranchType = resourceData.Get("branch_type").(string)
+	} else {
+		branchRestriction.Pattern = resourceData.Get("pattern").(string)
+	}
+
+	value := resourceData.Get("value").(int)
+	if value > 0 {
+		branchRestriction.Value = &value
+	}
+	branchRestriction.Users, branchRestriction.Groups = expandBranchRestrictionExemptions(
+		resourceData.Get("users").(*schema.Set).List(),
+		resourceData.Get("groups").(*schema.Set).List(),
+		groupSlugs,
+	)
+
+	return branchRestriction
+}
+
+// expandBranchRestrictionExemptions returns the given users & groups as Bitbucket expects them. Groups are sent by their
+// slug, so each configured group is looked up in the given slugs, with any that aren't found being sent as they are.
+func expandBranchRestrictionExemptions(users []interface{}, groups []interface{}, groupSlugs map[string]string) ([]v2.BranchRestrictionUser, []v2.BranchRestrictionGroup) {
+	expandedUsers := []v2.BranchRestrictionUser{}
+	for _, user := range parseBranchRestrictionUserFields(users) {
+		if isUUID(user) {
+			expandedUsers = append(expandedUsers, v2.BranchRestrictionUser{UUID: user})
+		} else {
+			expandedUsers = append(expandedUsers, v2.BranchRestrictionUser{Username: user})
+		}
+	}
+
+	expandedGroups := []v2.BranchRestrictionGroup{}
+	expandedSlugs := make(map[string]bool)
+	for _, group := range parseBranchRestrictionUserGroupFields(groups) {
+		if slug, ok := groupSlugs[strings.ToLower(group)]; ok {
+			group = slug
+		}
+		if expandedSlugs[strings.ToLower(group)] {
+			continue
+		}
+
+		expandedSlugs[strings.ToLower(group)] = true
+		expandedGroups = append(expandedGroups, v2.BranchRestrictionGroup{Slug: group})
+	}
+
+	return expandedUsers, expandedGroups
+}
+
+// branchRestrictionGroupSlugs returns the slug of each of the workspace's groups, keyed in lowercase by both its slug &
+// its name, as Bitbucket only accepts a group's slug. The workspace's groups are only looked up if any are configured.
+func branchRestrictionGroupSlugs(clients *Clients, workspace string, groups []interface{}) (map[string]string, error) {
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	workspaceUuid, err := clients.WorkspaceUuid(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceGroups, err := clients.Groups.List(workspaceUuid)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get groups with error: %s", err)
+	}
+
+	// Slugs are added last, so that a group's slug takes precedence over another group with the same name.
+	slugs := make(map[string]string)
+	for _, group := range workspaceGroups {
+		slugs[strings.ToLower(group.Name)] = group.Slug
+	}
+	for _, group := range workspaceGroups {
+		slugs[strings.ToLower(group.Slug)] = group.Slug
+	}
+
+	return slugs, nil
+}
+
+// validateBranchRestrictionBranchMatchKind ensures the attribute used to match branches agrees with
+// `branch_match_kind`, as Bitbucket silently ignores the other one.
+func validateBranchRestrictionBranchMatchKind(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	pattern := diff.Get("pattern").(string)
+	branchType := diff.Get("branch_type").(string)
+
+	switch diff.Get("branch_match_kind").(string) {
+	case "glob":
+		if branchType != "" {
+			return fmt.Errorf("`branch_type` can only be set when `branch_match_kind` is \"branching_model\", use `pattern` instead")
+		}
+	case "branching_model":
+		if pattern != "" {
+			return fmt.Errorf("`pattern` can only be set when `branch_match_kind` is \"glob\", use `branch_type` instead")
+		}
+	}
+
+	return nil
+}
+
+// validateBranchRestrictionExemptions ensures exempt users & groups are only given to the kinds of restriction which
+// support them, as Bitbucket otherwise accepts & silently discards them.
+func validateBranchRestrictionExemptions(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	kind := diff.Get("kind").(string)
+	if kind == "push" || kind == "restrict_merges" {
+		return nil
+	}
+
+	if len(diff.Get("users").(*schema.Set).List()) > 0 {
+		return fmt.Errorf("`users` can only be set when `kind` is \"push\" or \"restrict_merges\", not \"%s\"", kind)
+	}
+	if len(diff.Get("groups").(*schema.Set).List()) > 0 {
+		return fmt.Errorf("`groups` can only be set when `kind` is \"push\" or \"restrict_merges\", not \"%s\"", kind)
+	}
+
+	return nil
+}
+
+// flattenBranchRestrictionUsers returns the UUIDs of the given users.
+func flattenBranchRestrictionUsers(users []v2.BranchRestrictionUser) []interface{} {
+	var uuids []interface{}
+	for _, user := range users {
+		uuids = append(uuids, user.UUID)
+	}
+
+	return uuids
+}
+
+// flattenBranchRestrictionGroups returns the slugs of the given groups.
+func flattenBranchRestrictionGroups(groups []v2.BranchRestrictionGroup) []interface{} {
+	var slugs []interface{}
+	for _, group := range groups {
+		slugs = append(slugs, group.Slug)
+	}
+
+	return slugs
+}
+
+// setBranchRestrictionAliases records the UUID & slug Bitbucket resolved each configured username & group name to,
+// given the users & groups it returned once they were applied, so that they are recognised as the same users & groups
+// once they have been read back.
+func setBranchRestrictionAliases(resourceData *schema.ResourceData, configuredUsers []interface{}, configuredGroups []interface{}, users []v2.BranchRestrictionUser, groups []v2.BranchRestrictionGroup) {
+	var userIdentifiers, groupIdentifiers [][]string
+	for _, user := range users {
+		userIdentifiers = append(userIdentifiers, []string{user.UUID, user.Username, user.Nickname})
+	}
+	for _, group := range groups {
+		groupIdentifiers = append(groupIdentifiers, []string{group.Slug, group.Name})
+	}
+
+	_ = resourceData.Set("user_aliases", resolveBranchRestrictionAliases(
+		resourceData.Get("user_aliases").(map[string]interface{}),
+		configuredUsers,
+		userIdentifiers,
+	))
+	_ = resourceData.Set("group_aliases", resolveBranchRestrictionAliases(
+		resourceData.Get("group_aliases").(map[string]interface{}),
+		configuredGroups,
+		groupIdentifiers,
+	))
+}
+
+// resolveBranchRestrictionAliases takes a list of identifiers for each exemption, the first being its canonical form,
+// & returns the given aliases which still refer to one of them, along with an alias for every configured value which
+// refers to exactly one of them by another identifier. Aliases are keyed in lowercase, as Bitbucket ignores case.
+func resolveBranchRestrictionAliases(aliases map[string]interface{}, configured []interface{}, identifiers [][]string) map[string]interface{} {
+	resolved := make(map[string]interface{})
+
+	for alias, canonical := range aliases {
+		if slices.ContainsFunc(identifiers, func(ids []string) bool { return strings.EqualFold(ids[0], canonical.(string)) }) {
+			resolved[alias] = canonical
+		}
+	}
+
+	for _, value := range configured {
+		value := value.(string)
+		if slices.ContainsFunc(identifiers, func(ids []string) bool { return strings.EqualFold(ids[0], value) }) {
+			continue
+		}
+
+		var matches []string
+		for _, ids := range identifiers {
+			if slices.ContainsFunc(ids[1:], func(id string) bool { return id != "" && strings.EqualFold(id, value) }) {
+				matches = append(matches, ids[0])
+			}
+		}
+		if len(matches) == 1 {
+			resolved[strings.ToLower(value)] = matches[0]
+		}
+	}
+
+	return resolved
+}
+
+// suppressEquivalentBranchRestrictionExemptions suppresses the diff of a set of exempt users or groups when each
+// configured value is either one of those read back, or an alias recorded in the given attribute for one of them.
+func suppressEquivalentBranchRestrictionExemptions(aliases string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, resourceData *schema.ResourceData) bool {
+		current, configured := resourceData.GetChange(k[:strings.LastIndex(k, ".")])
+
+		return isEquivalentBranchRestrictionExemptions(
+			current.(*schema.Set).List(),
+			configured.(*schema.Set).List(),
+			resourceData.Get(aliases).(map[string]interface{}),
+		)
+	}
+}
+
+// hasBranchRestrictionExemptionsChange reports whether the given set of exempt users or groups has changed, other than
+// to an equivalent form which is suppressed.
+func hasBranchRestrictionExemptionsChange(diff *schema.ResourceDiff, key string, aliases string) bool {
+	current, configured := diff.GetChange(key)
+
+	return !isEquivalentBranchRestrictionExemptions(
+		current.(*schema.Set).List(),
+		configured.(*schema.Set).List(),
+		diff.Get(aliases).(map[string]interface{}),
+	)
+}
+
+func isEquivalentBranchRestrictionExemptions(current []interface{}, configured []interface{}, aliases map[string]interface{}) bool {
+	if len(current) != len(configured) {
+		return false
+	}
+
+	remaining := make(map[string]bool)
+	for _, value := range current {
+		remaining[strings.ToLower(value.(string))] = true
+	}
+
+	for _, value := range configured {
+		identifier := strings.ToLower(value.(string))
+		if canonical, ok := aliases[identifier]; ok {
+			identifier = strings.ToLower(canonical.(string))
+		}
+
+		if !remaining[identifier] {
+			return false
+		}
+		delete(remaining, identifier)
+	}
+
+	return true
+}
+
+// upgradeStateListsToSets upgrades the state of a resource where list attributes have become sets. Both are stored
+// the same way, so there is nothing to change other than the schema version.
+func upgradeStateListsToSets(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return rawState, nil
+}
+
+func isUUID(value string) bool {
+	return strings.HasPrefix(value, "{") && strings.HasSuffix(value, "}")
+}
+
 func parseBranchRestrictionUserFields(users []interface{}) []string {
 	var usersArray []string
 	for _, user := range users {
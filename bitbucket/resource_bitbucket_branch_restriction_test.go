@@ -1,7 +1,9 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"strconv"
 	"strings"
@@ -9,8 +11,11 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
 )
 
 func TestAccBitbucketBranchRestrictionResource_basic(t *testing.T) {
@@ -187,7 +192,8 @@ func TestAccBitbucketBranchRestrictionResource_withUsers(t *testing.T) {
 					resource.TestCheckResourceAttr("bitbucket_branch_restriction.testacc", "value", "0"),
 
 					resource.TestCheckResourceAttr("bitbucket_branch_restriction.testacc", "users.#", "1"),
-					resource.TestCheckResourceAttr("bitbucket_branch_restriction.testacc", "users.0", branchRestrictionUser),
+					resource.TestCheckResourceAttr("bitbucket_branch_restriction.testacc", "user_aliases.%", "1"),
+					resource.TestCheckTypeSetElemAttr("bitbucket_branch_restriction.testacc", "users.*", branchRestrictionUser),
 
 					resource.TestCheckNoResourceAttr("bitbucket_branch_restriction.testacc", "groups"),
 
@@ -210,6 +216,7 @@ func TestAccBitbucketBranchRestrictionResource_withUsers(t *testing.T) {
 					assert.Equal(t, branchRestrictionPattern, state.Attributes["pattern"])
 					assert.Equal(t, branchRestrictionKind, state.Attributes["kind"])
 					assert.Equal(t, "0", state.Attributes["value"])
+					assert.Equal(t, "1", state.Attributes["users.#"])
 
 					assert.NotEmpty(t, state.Attributes["id"])
 
@@ -280,7 +287,7 @@ func TestAccBitbucketBranchRestrictionResource_withGroups(t *testing.T) {
 					resource.TestCheckResourceAttr("bitbucket_branch_restriction.testacc", "value", "0"),
 
 					resource.TestCheckResourceAttr("bitbucket_branch_restriction.testacc", "groups.#", "1"),
-					resource.TestCheckResourceAttr("bitbucket_branch_restriction.testacc", "groups.0", groupName),
+					resource.TestCheckTypeSetElemAttr("bitbucket_branch_restriction.testacc", "groups.*", groupName),
 
 					resource.TestCheckNoResourceAttr("bitbucket_branch_restriction.testacc", "users"),
 
@@ -303,6 +310,7 @@ func TestAccBitbucketBranchRestrictionResource_withGroups(t *testing.T) {
 					assert.Equal(t, branchRestrictionPattern, state.Attributes["pattern"])
 					assert.Equal(t, branchRestrictionKind, state.Attributes["kind"])
 					assert.Equal(t, "0", state.Attributes["value"])
+					assert.Equal(t, "1", state.Attributes["groups.#"])
 
 					assert.NotEmpty(t, state.Attributes["id"])
 
@@ -375,10 +383,10 @@ func TestAccBitbucketBranchRestrictionResource_withUsersAndGroups(t *testing.T)
 					resource.TestCheckResourceAttr("bitbucket_branch_restriction.testacc", "value", "0"),
 
 					resource.TestCheckResourceAttr("bitbucket_branch_restriction.testacc", "groups.#", "1"),
-					resource.TestCheckResourceAttr("bitbucket_branch_restriction.testacc", "groups.0", groupName),
+					resource.TestCheckTypeSetElemAttr("bitbucket_branch_restriction.testacc", "groups.*", groupName),
 
 					resource.TestCheckResourceAttr("bitbucket_branch_restriction.testacc", "users.#", "1"),
-					resource.TestCheckResourceAttr("bitbucket_branch_restriction.testacc", "users.0", branchRestrictionUser),
+					resource.TestCheckTypeSetElemAttr("bitbucket_branch_restriction.testacc", "users.*", branchRestrictionUser),
 
 					resource.TestCheckResourceAttrSet("bitbucket_branch_restriction.testacc", "id"),
 				),
@@ -399,6 +407,8 @@ func TestAccBitbucketBranchRestrictionResource_withUsersAndGroups(t *testing.T)
 					assert.Equal(t, branchRestrictionPattern, state.Attributes["pattern"])
 					assert.Equal(t, branchRestrictionKind, state.Attributes["kind"])
 					assert.Equal(t, "0", state.Attributes["value"])
+					assert.Equal(t, "1", state.Attributes["users.#"])
+					assert.Equal(t, "1", state.Attributes["groups.#"])
 
 					assert.NotEmpty(t, state.Attributes["id"])
 
@@ -474,6 +484,164 @@ func TestAccBitbucketBranchRestrictionResource_withEmptyUsersAndEmptyGroups(t *t
 	})
 }
 
+func TestAccBitbucketBranchRestrictionResource_withBranchingModel(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	projectName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectKey := strings.ToUpper(acctest.RandStringFromCharSet(3, acctest.CharSetAlpha))
+	repoName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	branchRestrictionBranchType := "release"
+	branchRestrictionKind := "delete"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_project" "testacc" {
+					  workspace = data.bitbucket_workspace.testacc.id
+					  name      = "%s"
+					  key       = "%s"
+					}
+
+					resource "bitbucket_repository" "testacc" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  project_key = bitbucket_project.testacc.key
+					  name        = "%s"
+					}
+
+					resource "bitbucket_branch_restriction" "testacc" {
+					  workspace         = data.bitbucket_workspace.testacc.id
+					  repository        = bitbucket_repository.testacc.name
+					  branch_match_kind = "branching_model"
+					  branch_type       = "%s"
+					  kind              = "%s"
+					}`, workspaceSlug, projectName, projectKey, repoName, branchRestrictionBranchType, branchRestrictionKind),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_branch_restriction.testacc", "workspace", workspaceSlug),
+					resource.TestCheckResourceAttr("bitbucket_branch_restriction.testacc", "repository", repoName),
+					resource.TestCheckResourceAttr("bitbucket_branch_restriction.testacc", "branch_match_kind", "branching_model"),
+					resource.TestCheckResourceAttr("bitbucket_branch_restriction.testacc", "branch_type", branchRestrictionBranchType),
+					resource.TestCheckResourceAttr("bitbucket_branch_restriction.testacc", "pattern", ""),
+					resource.TestCheckResourceAttr("bitbucket_branch_restriction.testacc", "kind", branchRestrictionKind),
+					resource.TestCheckResourceAttr("bitbucket_branch_restriction.testacc", "value", "0"),
+
+					resource.TestCheckResourceAttrSet("bitbucket_branch_restriction.testacc", "id"),
+				),
+			},
+			{
+				ResourceName:      "bitbucket_branch_restriction.testacc",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					resources := state.Modules[0].Resources
+					branchRestResourceAttr := resources["bitbucket_branch_restriction.testacc"].Primary.Attributes
+					return fmt.Sprintf("%s/%s/%s", workspaceSlug, repoName, branchRestResourceAttr["id"]), nil
+				},
+			},
+		},
+	})
+}
+
+func TestFlattenBranchRestrictionUsers(t *testing.T) {
+	users := []v2.BranchRestrictionUser{
+		{UUID: "{user-a-uuid}", Nickname: "user-a"},
+		{UUID: "{user-b-uuid}", Nickname: "user-b"},
+	}
+
+	expected := []interface{}{"{user-a-uuid}", "{user-b-uuid}"}
+	assert.Equal(t, expected, flattenBranchRestrictionUsers(users))
+}
+
+func TestFlattenBranchRestrictionGroups(t *testing.T) {
+	groups := []v2.BranchRestrictionGroup{
+		{Slug: "group-a", Name: "Group A"},
+		{Slug: "group-b", Name: "Group B"},
+	}
+
+	expected := []interface{}{"group-a", "group-b"}
+	assert.Equal(t, expected, flattenBranchRestrictionGroups(groups))
+}
+
+func TestResolveBranchRestrictionAliases(t *testing.T) {
+	identifiers := [][]string{
+		{"{user-a-uuid}", "", "user-a"},
+		{"{user-b-uuid}", "", "user-b"},
+		{"{user-c-uuid}", "", "shared"},
+		{"{user-d-uuid}", "", "shared"},
+	}
+	aliases := map[string]interface{}{
+		"user-b":  "{user-b-uuid}",
+		"removed": "{removed-uuid}",
+	}
+	configured := []interface{}{"{user-a-uuid}", "User-A", "shared", "unknown"}
+
+	expected := map[string]interface{}{
+		"user-a": "{user-a-uuid}",
+		"user-b": "{user-b-uuid}",
+	}
+	assert.Equal(t, expected, resolveBranchRestrictionAliases(aliases, configured, identifiers))
+}
+
+func TestIsEquivalentBranchRestrictionExemptions(t *testing.T) {
+	aliases := map[string]interface{}{"user-a": "{user-a-uuid}"}
+	current := []interface{}{"{user-a-uuid}", "{user-b-uuid}"}
+
+	assert.True(t, isEquivalentBranchRestrictionExemptions(current, []interface{}{"{USER-B-UUID}", "{user-a-uuid}"}, aliases))
+	assert.True(t, isEquivalentBranchRestrictionExemptions(current, []interface{}{"User-A", "{user-b-uuid}"}, aliases))
+	assert.False(t, isEquivalentBranchRestrictionExemptions(current, []interface{}{"user-a", "user-b"}, aliases))
+	assert.False(t, isEquivalentBranchRestrictionExemptions(current, []interface{}{"user-a"}, aliases))
+	assert.False(t, isEquivalentBranchRestrictionExemptions(current, []interface{}{"user-a", "{user-a-uuid}"}, aliases))
+}
+
+func TestResourceBitbucketBranchRestrictionSuppressesEquivalentExemptions(t *testing.T) {
+	state := func(user string) *terraform.InstanceState {
+		return &terraform.InstanceState{
+			ID: "123",
+			Attributes: map[string]string{
+				"id":                   "123",
+				"workspace":            "workspace-slug",
+				"repository":           "example-repo",
+				"branch_match_kind":    "glob",
+				"pattern":              "master",
+				"kind":                 "push",
+				"value":                "0",
+				"users.#":              "1",
+				"users.0":              user,
+				"groups.#":             "1",
+				"groups.0":             "admins",
+				"user_aliases.%":       "1",
+				"user_aliases.user-a":  "{user-a-uuid}",
+				"group_aliases.%":      "1",
+				"group_aliases.admins": "admins",
+			},
+		}
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"workspace":  "workspace-slug",
+		"repository": "example-repo",
+		"pattern":    "master",
+		"kind":       "push",
+		"users":      []interface{}{"user-a"},
+		"groups":     []interface{}{"Admins"},
+	})
+
+	diff, err := resourceBitbucketBranchRestriction().Diff(context.Background(), state("{user-a-uuid}"), config, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, diff)
+
+	// The exemption has been changed outside of Terraform to another user, so the configured user no longer matches
+	diff, err = resourceBitbucketBranchRestriction().Diff(context.Background(), state("{user-z-uuid}"), config, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, diff)
+	assert.True(t, diff.Attributes["user_aliases.%"].NewComputed)
+	assert.Nil(t, diff.Attributes["groups.#"])
+}
+
 func TestParseBranchRestrictionUserFields(t *testing.T) {
 	users := []interface{}{"user-a", "user-b", "user-c"}
 	usersStrArr := parseBranchRestrictionUserFields(users)
@@ -493,3 +661,142 @@ func TestParseBranchRestrictionGroupFields(t *testing.T) {
 	}
 	assert.Equal(t, expected, usersStrArr)
 }
+
+func TestExpandBranchRestriction(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBitbucketBranchRestrictionSchema(), map[string]interface{}{
+		"workspace":  "workspace-slug",
+		"repository": "example-repo",
+		"pattern":    "master",
+		"kind":       "push",
+		"users":      []interface{}{"{user-uuid}"},
+		"groups":     []interface{}{"admins"},
+	})
+
+	branchRestriction := expandBranchRestriction(resourceData, nil)
+	assert.Equal(t, "push", branchRestriction.Kind)
+	assert.Equal(t, "glob", branchRestriction.BranchMatchKind)
+	assert.Equal(t, "master", branchRestriction.Pattern)
+	assert.Nil(t, branchRestriction.Value)
+	assert.Equal(t, []v2.BranchRestrictionUser{{UUID: "{user-uuid}"}}, branchRestriction.Users)
+	assert.Equal(t, []v2.BranchRestrictionGroup{{Slug: "admins"}}, branchRestriction.Groups)
+}
+
+func TestResourceBitbucketBranchRestrictionSetsExemptions(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBitbucketBranchRestrictionSchema(), map[string]interface{}{})
+
+	users := []v2.BranchRestrictionUser{{UUID: "{user-uuid}", Nickname: "user"}}
+	groups := []v2.BranchRestrictionGroup{{Slug: "admins", Name: "Admins"}}
+	assert.NoError(t, resourceData.Set("users", flattenBranchRestrictionUsers(users)))
+	assert.NoError(t, resourceData.Set("groups", flattenBranchRestrictionGroups(groups)))
+
+	assert.Equal(t, []interface{}{"{user-uuid}"}, resourceData.Get("users").(*schema.Set).List())
+	assert.Equal(t, []interface{}{"admins"}, resourceData.Get("groups").(*schema.Set).List())
+}
+
+func TestResourceBitbucketBranchRestrictionDiff(t *testing.T) {
+	diff := func(kind string) error {
+		_, err := resourceBitbucketBranchRestriction().Diff(
+			context.Background(),
+			nil,
+			terraform.NewResourceConfigRaw(map[string]interface{}{
+				"workspace":  "workspace-slug",
+				"repository": "example-repo",
+				"pattern":    "master",
+				"kind":       kind,
+				"users":      []interface{}{"{user-uuid}"},
+				"groups":     []interface{}{"admins"},
+			}),
+			nil,
+		)
+		return err
+	}
+
+	assert.NoError(t, diff("push"))
+	assert.NoError(t, diff("restrict_merges"))
+	assert.ErrorContains(t, diff("delete"), "`users` can only be set when `kind` is \"push\" or \"restrict_merges\", not \"delete\"")
+}
+
+func TestResourceBitbucketBranchRestrictionStateUpgradeV0(t *testing.T) {
+	currentSchema := resourceBitbucketBranchRestrictionSchema()
+	assert.Equal(t, schema.TypeSet, currentSchema["users"].Type)
+	assert.Equal(t, schema.TypeSet, currentSchema["groups"].Type)
+
+	v0Schema := resourceBitbucketBranchRestrictionV0().Schema
+	assert.Equal(t, schema.TypeList, v0Schema["users"].Type)
+	assert.Equal(t, schema.TypeList, v0Schema["groups"].Type)
+
+	rawState := map[string]interface{}{
+		"id":     "123",
+		"kind":   "push",
+		"users":  []interface{}{"{user-uuid}"},
+		"groups": []interface{}{"admins", "developers"},
+	}
+
+	upgraded, err := upgradeStateListsToSets(context.Background(), rawState, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, rawState, upgraded)
+}
+
+func TestExpandBranchRestrictionExemptions(t *testing.T) {
+	groupSlugs := map[string]string{"developers": "devs", "devs": "devs"}
+
+	users, groups := expandBranchRestrictionExemptions(
+		[]interface{}{"{user-uuid}", "user"},
+		[]interface{}{"Developers", "devs", "unknown"},
+		groupSlugs,
+	)
+
+	assert.Equal(t, []v2.BranchRestrictionUser{{UUID: "{user-uuid}"}, {Username: "user"}}, users)
+	assert.ElementsMatch(t, []v2.BranchRestrictionGroup{{Slug: "devs"}, {Slug: "unknown"}}, groups)
+}
+
+func TestBranchRestrictionGroupSlugs(t *testing.T) {
+	clients := newTestClients(t, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, "/internal/workspaces/%7Bworkspace-uuid%7D/groups", request.URL.EscapedPath())
+
+		_, _ = writer.Write([]byte(`[{"name":"Developers","slug":"devs"},{"name":"devs","slug":"developers"}]`))
+	})
+
+	groupSlugs, err := branchRestrictionGroupSlugs(clients, "{workspace-uuid}", []interface{}{"Developers"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"developers": "developers", "devs": "devs"}, groupSlugs)
+
+	groupSlugs, err = branchRestrictionGroupSlugs(newTestClients(t, nil), "{workspace-uuid}", nil)
+	assert.NoError(t, err)
+	assert.Nil(t, groupSlugs)
+}
+
+func TestResourceBitbucketBranchRestrictionReadRefreshesAliases(t *testing.T) {
+	clients := newTestClients(t, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, "/2.0/repositories/workspace-slug/example-repo/branch-restrictions/123", request.URL.EscapedPath())
+
+		_, _ = writer.Write([]byte(`{
+			"id": 123,
+			"kind": "push",
+			"branch_match_kind": "glob",
+			"pattern": "master",
+			"users": [{"uuid": "{user-z-uuid}", "nickname": "user-z"}],
+			"groups": [{"slug": "admins", "name": "Admins"}]
+		}`))
+	})
+
+	resourceData := schema.TestResourceDataRaw(t, resourceBitbucketBranchRestrictionSchema(), map[string]interface{}{
+		"workspace":  "workspace-slug",
+		"repository": "example-repo",
+		"pattern":    "master",
+		"kind":       "push",
+		"users":      []interface{}{"user-a"},
+		"groups":     []interface{}{"Admins"},
+	})
+	resourceData.SetId("123")
+	assert.NoError(t, resourceData.Set("user_aliases", map[string]interface{}{"user-a": "{user-a-uuid}"}))
+	assert.NoError(t, resourceData.Set("group_aliases", map[string]interface{}{"admins": "admins"}))
+
+	// The exempt user has been changed outside of Terraform, so its alias no longer refers to anything read back
+	diags := resourceBitbucketBranchRestrictionRead(context.Background(), resourceData, clients)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []interface{}{"{user-z-uuid}"}, resourceData.Get("users").(*schema.Set).List())
+	assert.Equal(t, []interface{}{"admins"}, resourceData.Get("groups").(*schema.Set).List())
+	assert.Equal(t, map[string]interface{}{}, resourceData.Get("user_aliases"))
+	assert.Equal(t, map[string]interface{}{"admins": "admins"}, resourceData.Get("group_aliases"))
+}
@@ -0,0 +1,75 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBitbucketBranchResource_basic(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	projectName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectKey := strings.ToUpper(acctest.RandStringFromCharSet(3, acctest.CharSetAlpha))
+	repoName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	branchName := "release/2026.10"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_project" "testacc" {
+					  workspace = data.bitbucket_workspace.testacc.id
+					  name      = "%s"
+					  key       = "%s"
+					}
+
+					resource "bitbucket_repository" "testacc" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  project_key = bitbucket_project.testacc.key
+					  name        = "%s"
+					}
+
+					resource "bitbucket_repository_file" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.slug
+					  branch     = "master"
+					  path       = "README.md"
+					  content    = "# TF ACC Test Repository"
+					}
+
+					resource "bitbucket_branch" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository_file.testacc.repository
+					  name       = "%s"
+					  target     = bitbucket_repository_file.testacc.branch
+					}`, workspaceSlug, projectName, projectKey, repoName, branchName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_branch.testacc", "id", fmt.Sprintf("%s/%s/%s", workspaceSlug, repoName, branchName)),
+					resource.TestCheckResourceAttr("bitbucket_branch.testacc", "workspace", workspaceSlug),
+					resource.TestCheckResourceAttr("bitbucket_branch.testacc", "repository", repoName),
+					resource.TestCheckResourceAttr("bitbucket_branch.testacc", "name", branchName),
+					resource.TestCheckResourceAttr("bitbucket_branch.testacc", "target", "master"),
+					resource.TestMatchResourceAttr("bitbucket_branch.testacc", "hash", regexp.MustCompile("^[0-9a-f]{40}$")),
+				),
+			},
+			{
+				ResourceName:            "bitbucket_branch.testacc",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s/%s/%s", workspaceSlug, repoName, branchName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"target"},
+			},
+		},
+	})
+}
@@ -0,0 +1,212 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+var commitStatusStates = []string{"SUCCESSFUL", "FAILED", "INPROGRESS", "STOPPED"}
+
+func resourceBitbucketCommitStatus() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBitbucketCommitStatusCreate,
+		ReadContext:   resourceBitbucketCommitStatusRead,
+		UpdateContext: resourceBitbucketCommitStatusUpdate,
+		DeleteContext: resourceBitbucketCommitStatusDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBitbucketCommitStatusImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the commit status.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"workspace": {
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"repository": {
+				Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateRepositoryName,
+			},
+			"commit": {
+				Description: "The hash of the commit to report the status against.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"key": {
+				Description:  "A key that uniquely identifies the build (e.g. the name of the CI job) amongst the commit's statuses.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 40),
+			},
+			"state": {
+				Description:  "The state of the build. Must be one of 'SUCCESSFUL', 'FAILED', 'INPROGRESS', 'STOPPED'.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(commitStatusStates, false),
+			},
+			"url": {
+				Description:  "The url of the build, which the status links to.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"name": {
+				Description: "The name of the build.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": {
+				Description: "A description of the build (e.g. \"3 of 4 tests passed\").",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"refname": {
+				Description: "The name of the branch or tag the build was run for.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"created_on": {
+				Description: "When the status was first reported.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_on": {
+				Description: "When the status was last updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBitbucketCommitStatusCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	_, err := client.CommitStatuses.Create(
+		&v2.CommitStatusOptions{
+			Owner:        resourceData.Get("workspace").(string),
+			RepoSlug:     resourceData.Get("repository").(string),
+			Commit:       resourceData.Get("commit").(string),
+			CommitStatus: expandCommitStatus(resourceData),
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to create commit status with error: %s", err))
+	}
+
+	return resourceBitbucketCommitStatusRead(ctx, resourceData, meta)
+}
+
+func resourceBitbucketCommitStatusRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	commitStatus, err := client.CommitStatuses.Get(
+		&v2.CommitStatusOptions{
+			Owner:    resourceData.Get("workspace").(string),
+			RepoSlug: resourceData.Get("repository").(string),
+			Commit:   resourceData.Get("commit").(string),
+			Key:      resourceData.Get("key").(string),
+		},
+	)
+	if err != nil {
+		// If the commit has been removed outside of Terraform (e.g. by a force push), its statuses go with it, so we
+		// remove it from state so that it will be re-created.
+		if v2.IsNotFound(err) && resourceData.Id() != "" {
+			resourceData.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(fmt.Errorf("unable to get commit status with error: %s", err))
+	}
+
+	_ = resourceData.Set("state", commitStatus.State)
+	_ = resourceData.Set("url", commitStatus.URL)
+	_ = resourceData.Set("name", commitStatus.Name)
+	_ = resourceData.Set("description", commitStatus.Description)
+	_ = resourceData.Set("refname", commitStatus.Refname)
+	_ = resourceData.Set("created_on", commitStatus.CreatedOn)
+	_ = resourceData.Set("updated_on", commitStatus.UpdatedOn)
+
+	resourceData.SetId(fmt.Sprintf(
+		"%s/%s/%s/%s",
+		resourceData.Get("workspace").(string),
+		resourceData.Get("repository").(string),
+		resourceData.Get("commit").(string),
+		commitStatus.Key,
+	))
+
+	return nil
+}
+
+func resourceBitbucketCommitStatusUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	_, err := client.CommitStatuses.Update(
+		&v2.CommitStatusOptions{
+			Owner:        resourceData.Get("workspace").(string),
+			RepoSlug:     resourceData.Get("repository").(string),
+			Commit:       resourceData.Get("commit").(string),
+			Key:          resourceData.Get("key").(string),
+			CommitStatus: expandCommitStatus(resourceData),
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to update commit status with error: %s", err))
+	}
+
+	return resourceBitbucketCommitStatusRead(ctx, resourceData, meta)
+}
+
+func resourceBitbucketCommitStatusDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Bitbucket does not allow a commit status to be deleted, so it is only removed from state.
+	resourceData.SetId("")
+
+	return nil
+}
+
+func resourceBitbucketCommitStatusImport(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ret := []*schema.ResourceData{resourceData}
+
+	splitID := strings.SplitN(resourceData.Id(), "/", 4)
+	if len(splitID) < 4 {
+		return ret, fmt.Errorf("invalid import ID. It must to be in this format \"<workspace-slug|workspace-uuid>/<repository-name>/<commit-hash>/<key>\"")
+	}
+
+	_ = resourceData.Set("workspace", splitID[0])
+	_ = resourceData.Set("repository", splitID[1])
+	_ = resourceData.Set("commit", splitID[2])
+	_ = resourceData.Set("key", splitID[3])
+
+	_ = resourceBitbucketCommitStatusRead(ctx, resourceData, meta)
+
+	return ret, nil
+}
+
+func expandCommitStatus(resourceData *schema.ResourceData) *v2.CommitStatus {
+	return &v2.CommitStatus{
+		Key:         resourceData.Get("key").(string),
+		State:       resourceData.Get("state").(string),
+		URL:         resourceData.Get("url").(string),
+		Name:        resourceData.Get("name").(string),
+		Description: resourceData.Get("description").(string),
+		Refname:     resourceData.Get("refname").(string),
+	}
+}
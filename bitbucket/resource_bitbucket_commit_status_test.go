@@ -0,0 +1,104 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccBitbucketCommitStatusResource_basic(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	projectName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectKey := strings.ToUpper(acctest.RandStringFromCharSet(3, acctest.CharSetAlpha))
+	repoName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	config := func(state string, description string) string {
+		return fmt.Sprintf(`
+			data "bitbucket_workspace" "testacc" {
+				id = "%s"
+			}
+
+			resource "bitbucket_project" "testacc" {
+			  workspace = data.bitbucket_workspace.testacc.id
+			  name      = "%s"
+			  key       = "%s"
+			}
+
+			resource "bitbucket_repository" "testacc" {
+			  workspace   = data.bitbucket_workspace.testacc.id
+			  project_key = bitbucket_project.testacc.key
+			  name        = "%s"
+			}
+
+			resource "bitbucket_repository_file" "testacc" {
+			  workspace  = data.bitbucket_workspace.testacc.id
+			  repository = bitbucket_repository.testacc.slug
+			  branch     = "master"
+			  path       = "README.md"
+			  content    = "# TF ACC Test Repository"
+			}
+
+			data "bitbucket_branch" "testacc" {
+			  workspace  = data.bitbucket_workspace.testacc.id
+			  repository = bitbucket_repository_file.testacc.repository
+			  name       = bitbucket_repository_file.testacc.branch
+			}
+
+			resource "bitbucket_commit_status" "testacc" {
+			  workspace   = data.bitbucket_workspace.testacc.id
+			  repository  = bitbucket_repository.testacc.slug
+			  commit      = data.bitbucket_branch.testacc.hash
+			  key         = "tf-acc-test"
+			  state       = "%s"
+			  url         = "https://example.com/builds/1"
+			  name        = "TF ACC Test Build"
+			  description = "%s"
+			  refname     = "master"
+			}`, workspaceSlug, projectName, projectKey, repoName, state, description)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config("INPROGRESS", "Building"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_commit_status.testacc", "workspace", workspaceSlug),
+					resource.TestCheckResourceAttr("bitbucket_commit_status.testacc", "repository", repoName),
+					resource.TestCheckResourceAttrPair("bitbucket_commit_status.testacc", "commit", "data.bitbucket_branch.testacc", "hash"),
+					resource.TestCheckResourceAttr("bitbucket_commit_status.testacc", "key", "tf-acc-test"),
+					resource.TestCheckResourceAttr("bitbucket_commit_status.testacc", "state", "INPROGRESS"),
+					resource.TestCheckResourceAttr("bitbucket_commit_status.testacc", "url", "https://example.com/builds/1"),
+					resource.TestCheckResourceAttr("bitbucket_commit_status.testacc", "name", "TF ACC Test Build"),
+					resource.TestCheckResourceAttr("bitbucket_commit_status.testacc", "description", "Building"),
+					resource.TestCheckResourceAttr("bitbucket_commit_status.testacc", "refname", "master"),
+					resource.TestCheckResourceAttrSet("bitbucket_commit_status.testacc", "created_on"),
+					resource.TestCheckResourceAttrSet("bitbucket_commit_status.testacc", "updated_on"),
+				),
+			},
+			{
+				Config: config("SUCCESSFUL", "All tests passed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_commit_status.testacc", "state", "SUCCESSFUL"),
+					resource.TestCheckResourceAttr("bitbucket_commit_status.testacc", "description", "All tests passed"),
+				),
+			},
+			{
+				ResourceName:      "bitbucket_commit_status.testacc",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					resources := state.Modules[0].Resources
+					commitStatusResourceAttr := resources["bitbucket_commit_status.testacc"].Primary.Attributes
+					return fmt.Sprintf("%s/%s/%s/tf-acc-test", workspaceSlug, repoName, commitStatusResourceAttr["commit"]), nil
+				},
+			},
+		},
+	})
+}
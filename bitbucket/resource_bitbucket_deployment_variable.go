@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	gobb "github.com/ktrysmt/go-bitbucket"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
 )
 
 func resourceBitbucketDeploymentVariable() *schema.Resource {
@@ -106,9 +108,10 @@ func resourceBitbucketDeploymentVariableRead(ctx context.Context, resourceData *
 		return diag.FromErr(fmt.Errorf("unable to get deployment variable with error: %s", err))
 	}
 
+	// When importing, the key isn't known yet, so the variable is also matched by its UUID.
 	var deploymentVariable *gobb.DeploymentVariable
 	for _, deploymentVar := range deploymentVariables.Variables {
-		if deploymentVar.Key == resourceData.Get("key").(string) {
+		if deploymentVar.Uuid == resourceData.Id() || deploymentVar.Key == resourceData.Get("key").(string) {
 			deploymentVariable = &deploymentVar
 			break
 		}
@@ -179,18 +182,61 @@ func resourceBitbucketDeploymentVariableImport(ctx context.Context, resourceData
 
 	splitID := strings.Split(resourceData.Id(), "/")
 	if len(splitID) < 3 {
-		return ret, fmt.Errorf("invalid import ID. It must to be in this format \"<workspace-slug|workspace-uuid>/<repository-slug|repository-uuid>/<deployment-variable-uuid>\"")
+		return ret, fmt.Errorf("invalid import ID. It must to be in this format \"<workspace-slug|workspace-uuid>/<repository-slug|repository-uuid>/<deployment-uuid>/<deployment-variable-uuid>\" or \"<workspace-slug|workspace-uuid>/<repository-slug|repository-uuid>/<deployment-variable-uuid>\"")
 	}
 
 	_ = resourceData.Set("workspace", splitID[0])
 	_ = resourceData.Set("repository", splitID[1])
-	_ = resourceData.Set("id", splitID[2])
+
+	// The original form of the ID leaves out the deployment, so it has to be found by looking through each of them.
+	if len(splitID) == 3 {
+		deployment, err := findDeploymentVariableDeployment(meta.(*Clients), splitID[0], splitID[1], splitID[2])
+		if err != nil {
+			return ret, err
+		}
+
+		_ = resourceData.Set("deployment", deployment)
+		resourceData.SetId(splitID[2])
+	} else {
+		_ = resourceData.Set("deployment", splitID[2])
+		resourceData.SetId(splitID[3])
+	}
 
 	_ = resourceBitbucketDeploymentVariableRead(ctx, resourceData, meta)
 
 	return ret, nil
 }
 
+// findDeploymentVariableDeployment returns the UUID of the deployment the variable with the given UUID belongs to.
+func findDeploymentVariableDeployment(clients *Clients, workspace string, repository string, uuid string) (string, error) {
+	environments, err := clients.V2Ext.Environments.List(&v2.EnvironmentOptions{Owner: workspace, RepoSlug: repository})
+	if err != nil {
+		return "", fmt.Errorf("unable to get deployments with error: %s", err)
+	}
+
+	for _, environment := range environments {
+		deploymentVariables, err := clients.V2.Repositories.Repository.ListDeploymentVariables(
+			&gobb.RepositoryDeploymentVariablesOptions{
+				Owner:       workspace,
+				RepoSlug:    repository,
+				Environment: &gobb.Environment{Uuid: environment.UUID},
+				Pagelen:     1000,
+			},
+		)
+		if err != nil {
+			return "", fmt.Errorf("unable to get deployment variables with error: %s", err)
+		}
+
+		for _, deploymentVariable := range deploymentVariables.Variables {
+			if deploymentVariable.Uuid == uuid {
+				return environment.UUID, nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("unable to find deployment variable %s in any of the repository's deployments", uuid)
+}
+
 func validateDeploymentVariableName(val interface{}, path cty.Path) diag.Diagnostics {
 	match, _ := regexp.MatchString("^([a-zA-Z_])[a-zA-Z0-9_]+$", val.(string))
 	if !match {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccBitbucketDeploymentVariableResource_basic(t *testing.T) {
@@ -162,6 +163,26 @@ func TestAccBitbucketDeploymentVariableResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("bitbucket_deployment_variable.testacc", "deployment"),
 				),
 			},
+			{
+				ResourceName:      "bitbucket_deployment_variable.testacc",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					resources := state.Modules[0].Resources
+					deploymentVariableResourceAttr := resources["bitbucket_deployment_variable.testacc"].Primary.Attributes
+					return fmt.Sprintf("%s/%s/%s/%s", workspaceSlug, repoName, deploymentVariableResourceAttr["deployment"], deploymentVariableResourceAttr["id"]), nil
+				},
+			},
+			{
+				ResourceName:      "bitbucket_deployment_variable.testacc",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					resources := state.Modules[0].Resources
+					deploymentVariableResourceAttr := resources["bitbucket_deployment_variable.testacc"].Primary.Attributes
+					return fmt.Sprintf("%s/%s/%s", workspaceSlug, repoName, deploymentVariableResourceAttr["id"]), nil
+				},
+			},
 		},
 	})
 }
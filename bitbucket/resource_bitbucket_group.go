@@ -6,10 +6,11 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
-	v1 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v1"
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
 )
 
 func resourceBitbucketGroup() *schema.Resource {
@@ -21,6 +22,9 @@ func resourceBitbucketGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceBitbucketGroupImport,
 		},
+		CustomizeDiff: customdiff.ComputedIf("slug", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+			return diff.HasChange("name")
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Description: "The ID of the group.",
@@ -28,9 +32,20 @@ func resourceBitbucketGroup() *schema.Resource {
 				Computed:    true,
 			},
 			"workspace": {
-				Description: "The UUID (including the enclosing `{}`) of the workspace this group belongs to.",
+				Description:      "The slug or UUID (including the enclosing `{}`) of the workspace this group belongs to.",
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentWorkspaceDiff,
+			},
+			"workspace_slug": {
+				Description: "The slug of the workspace.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Computed:    true,
+			},
+			"workspace_uuid": {
+				Description: "The UUID (including the enclosing `{}`) of the workspace.",
+				Type:        schema.TypeString,
+				Computed:    true,
 			},
 			"name": {
 				Description: "The name of the group.",
@@ -49,17 +64,34 @@ func resourceBitbucketGroup() *schema.Resource {
 				Default:      "none",
 				ValidateFunc: validation.StringInSlice([]string{"none", "read", "write", "admin"}, false),
 			},
+			"auto_add": {
+				Description: "Whether new members of the workspace are automatically added to this group.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"email_forwarding_disabled": {
+				Description: "Whether emails sent to this group are no longer forwarded to its members.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
 		},
 	}
 }
 
 func resourceBitbucketGroupCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).V1
+	client := meta.(*Clients).Groups
+
+	workspaceUuid, err := meta.(*Clients).WorkspaceUuid(resourceData.Get("workspace").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	group, err := client.Groups.Create(
-		&v1.GroupOptions{
-			OwnerUuid: resourceData.Get("workspace").(string),
-			Name:      resourceData.Get("name").(string),
+	group, err := client.Create(
+		workspaceUuid,
+		&v2.Group{
+			Name: resourceData.Get("name").(string),
 		},
 	)
 	if err != nil {
@@ -74,35 +106,53 @@ func resourceBitbucketGroupCreate(ctx context.Context, resourceData *schema.Reso
 }
 
 func resourceBitbucketGroupRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).V1
+	client := meta.(*Clients).Groups
 
-	group, err := client.Groups.Get(
-		&v1.GroupOptions{
-			OwnerUuid: resourceData.Get("workspace").(string),
-			Slug:      resourceData.Get("slug").(string),
-		},
-	)
+	workspace, err := meta.(*Clients).Workspace(resourceData.Get("workspace").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to get workspace with error: %s", err))
+	}
+
+	group, err := client.Get(workspace.UUID, resourceData.Get("slug").(string))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to get group with error: %s", err))
 	}
 
 	_ = resourceData.Set("name", group.Name)
 	_ = resourceData.Set("permission", group.Permission)
+	_ = resourceData.Set("auto_add", group.AutoAdd)
+	_ = resourceData.Set("email_forwarding_disabled", group.EmailForwardingDisabled)
+	_ = resourceData.Set("workspace_slug", workspace.Slug)
+	_ = resourceData.Set("workspace_uuid", workspace.UUID)
 
-	resourceData.SetId(generateGroupResourceId(group.Owner.Uuid, group.Slug))
+	resourceData.SetId(generateGroupResourceId(workspace.UUID, group.Slug))
 
 	return nil
 }
 
 func resourceBitbucketGroupUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).V1
-
-	group, err := client.Groups.Update(
-		&v1.GroupOptions{
-			OwnerUuid:  resourceData.Get("workspace").(string),
-			Slug:       resourceData.Get("slug").(string),
-			Name:       resourceData.Get("name").(string),
-			Permission: resourceData.Get("permission").(string),
+	client := meta.(*Clients).Groups
+
+	workspaceUuid, err := meta.(*Clients).WorkspaceUuid(resourceData.Get("workspace").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Renaming a group also changes its slug, so until it has been updated it must be addressed by its previous slug.
+	slug := resourceData.Get("slug").(string)
+	if !resourceData.IsNewResource() {
+		previousSlug, _ := resourceData.GetChange("slug")
+		slug = previousSlug.(string)
+	}
+
+	group, err := client.Update(
+		workspaceUuid,
+		slug,
+		&v2.Group{
+			Name:                    resourceData.Get("name").(string),
+			Permission:              resourceData.Get("permission").(string),
+			AutoAdd:                 resourceData.Get("auto_add").(bool),
+			EmailForwardingDisabled: resourceData.Get("email_forwarding_disabled").(bool),
 		},
 	)
 	if err != nil {
@@ -115,14 +165,14 @@ func resourceBitbucketGroupUpdate(ctx context.Context, resourceData *schema.Reso
 }
 
 func resourceBitbucketGroupDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).V1
+	client := meta.(*Clients).Groups
 
-	err := client.Groups.Delete(
-		&v1.GroupOptions{
-			OwnerUuid: resourceData.Get("workspace").(string),
-			Slug:      resourceData.Get("slug").(string),
-		},
-	)
+	workspaceUuid, err := meta.(*Clients).WorkspaceUuid(resourceData.Get("workspace").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.Delete(workspaceUuid, resourceData.Get("slug").(string))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to delete group with error: %s", err))
 	}
@@ -137,7 +187,7 @@ func resourceBitbucketGroupImport(ctx context.Context, resourceData *schema.Reso
 
 	splitID := strings.Split(resourceData.Id(), "/")
 	if len(splitID) < 2 {
-		return ret, fmt.Errorf("invalid import ID. It must to be in this format \"<workspace-uuid>/<group-slug>\"")
+		return ret, fmt.Errorf("invalid import ID. It must to be in this format \"<workspace-slug|workspace-uuid>/<group-slug>\"")
 	}
 
 	_ = resourceData.Set("workspace", splitID[0])
@@ -7,8 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	v1 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v1"
 )
 
 func resourceBitbucketGroupMember() *schema.Resource {
@@ -26,10 +24,21 @@ func resourceBitbucketGroupMember() *schema.Resource {
 				Computed:    true,
 			},
 			"workspace": {
+				Description:      "The slug or UUID (including the enclosing `{}`) of the workspace.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentWorkspaceDiff,
+			},
+			"workspace_slug": {
+				Description: "The slug of the workspace.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"workspace_uuid": {
 				Description: "The UUID (including the enclosing `{}`) of the workspace.",
 				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Computed:    true,
 			},
 			"group": {
 				Description: "The slug of the group.",
@@ -48,15 +57,14 @@ func resourceBitbucketGroupMember() *schema.Resource {
 }
 
 func resourceBitbucketGroupMemberCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).V1
+	client := meta.(*Clients).Groups
 
-	_, err := client.GroupMembers.Create(
-		&v1.GroupMemberOptions{
-			OwnerUuid: resourceData.Get("workspace").(string),
-			Slug:      resourceData.Get("group").(string),
-			UserUuid:  resourceData.Get("user").(string),
-		},
-	)
+	workspaceUuid, err := meta.(*Clients).WorkspaceUuid(resourceData.Get("workspace").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.AddMember(workspaceUuid, resourceData.Get("group").(string), resourceData.Get("user").(string))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to create group member with error: %s", err))
 	}
@@ -65,32 +73,29 @@ func resourceBitbucketGroupMemberCreate(ctx context.Context, resourceData *schem
 }
 
 func resourceBitbucketGroupMemberRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).V1
+	client := meta.(*Clients).Groups
 
-	group, err := client.Groups.Get(
-		&v1.GroupOptions{
-			OwnerUuid: resourceData.Get("workspace").(string),
-			Slug:      resourceData.Get("group").(string),
-		},
-	)
+	workspace, err := meta.(*Clients).Workspace(resourceData.Get("workspace").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to get workspace with error: %s", err))
+	}
+
+	group, err := client.Get(workspace.UUID, resourceData.Get("group").(string))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to get group with error: %s", err))
 	}
 
-	groupMembers, err := client.GroupMembers.Get(
-		&v1.GroupMemberOptions{
-			OwnerUuid: resourceData.Get("workspace").(string),
-			Slug:      resourceData.Get("group").(string),
-			UserUuid:  resourceData.Get("user").(string),
-		},
-	)
+	_ = resourceData.Set("workspace_slug", workspace.Slug)
+	_ = resourceData.Set("workspace_uuid", workspace.UUID)
+
+	groupMembers, err := client.ListMembers(workspace.UUID, group.Slug)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to get group member with error: %s", err))
 	}
 
 	for _, member := range groupMembers {
 		if strings.EqualFold(resourceData.Get("user").(string), member.UUID) {
-			resourceData.SetId(generateGroupMemberId(group.Owner.Uuid, group.Slug, member.UUID))
+			resourceData.SetId(generateGroupMemberId(workspace.UUID, group.Slug, member.UUID))
 		}
 	}
 
@@ -98,15 +103,14 @@ func resourceBitbucketGroupMemberRead(ctx context.Context, resourceData *schema.
 }
 
 func resourceBitbucketGroupMemberDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).V1
+	client := meta.(*Clients).Groups
 
-	err := client.GroupMembers.Delete(
-		&v1.GroupMemberOptions{
-			OwnerUuid: resourceData.Get("workspace").(string),
-			Slug:      resourceData.Get("group").(string),
-			UserUuid:  resourceData.Get("user").(string),
-		},
-	)
+	workspaceUuid, err := meta.(*Clients).WorkspaceUuid(resourceData.Get("workspace").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.RemoveMember(workspaceUuid, resourceData.Get("group").(string), resourceData.Get("user").(string))
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to delete group member with error: %s", err))
 	}
@@ -121,7 +125,7 @@ func resourceBitbucketGroupMemberImport(ctx context.Context, resourceData *schem
 
 	splitID := strings.Split(resourceData.Id(), "/")
 	if len(splitID) < 3 {
-		return ret, fmt.Errorf("invalid import ID. It must to be in this format \"<workspace-uuid>/<group-slug>/<user-uuid>\"")
+		return ret, fmt.Errorf("invalid import ID. It must to be in this format \"<workspace-slug|workspace-uuid>/<group-slug>/<user-uuid>\"")
 	}
 
 	_ = resourceData.Set("workspace", splitID[0])
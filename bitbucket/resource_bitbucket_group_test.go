@@ -138,6 +138,8 @@ func TestAccBitbucketGroupResource_changeProperties(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("bitbucket_group.testacc", "name", groupName),
 					resource.TestCheckResourceAttr("bitbucket_group.testacc", "permission", "read"),
+					resource.TestCheckResourceAttr("bitbucket_group.testacc", "auto_add", "false"),
+					resource.TestCheckResourceAttr("bitbucket_group.testacc", "email_forwarding_disabled", "false"),
 					resource.TestCheckResourceAttr("bitbucket_group.testacc", "slug", groupName),
 
 					resource.TestCheckResourceAttrSet("bitbucket_group.testacc", "workspace"),
@@ -151,13 +153,17 @@ func TestAccBitbucketGroupResource_changeProperties(t *testing.T) {
 					}
 
 					resource "bitbucket_group" "testacc" {
-					  workspace  = data.bitbucket_workspace.testacc.uuid
-					  name       = "%s"
-					  permission = "write"
+					  workspace                 = data.bitbucket_workspace.testacc.uuid
+					  name                      = "%s"
+					  permission                = "write"
+					  auto_add                  = true
+					  email_forwarding_disabled = true
 					}`, workspaceSlug, groupName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("bitbucket_group.testacc", "name", groupName),
 					resource.TestCheckResourceAttr("bitbucket_group.testacc", "permission", "write"),
+					resource.TestCheckResourceAttr("bitbucket_group.testacc", "auto_add", "true"),
+					resource.TestCheckResourceAttr("bitbucket_group.testacc", "email_forwarding_disabled", "true"),
 					resource.TestCheckResourceAttr("bitbucket_group.testacc", "slug", groupName),
 
 					resource.TestCheckResourceAttrSet("bitbucket_group.testacc", "workspace"),
@@ -239,6 +245,54 @@ func TestAccBitbucketGroupResource_withoutProperties(t *testing.T) {
 	})
 }
 
+func TestAccBitbucketGroupResource_workspaceSlug(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	groupName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_group" "testacc" {
+					  workspace = data.bitbucket_workspace.testacc.id
+					  name      = "%s"
+					}`, workspaceSlug, groupName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_group.testacc", "workspace", workspaceSlug),
+					resource.TestCheckResourceAttr("bitbucket_group.testacc", "workspace_slug", workspaceSlug),
+					resource.TestCheckResourceAttrPair("bitbucket_group.testacc", "workspace_uuid", "data.bitbucket_workspace.testacc", "uuid"),
+					resource.TestCheckResourceAttr("bitbucket_group.testacc", "name", groupName),
+					resource.TestCheckResourceAttr("bitbucket_group.testacc", "slug", groupName),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_group" "testacc" {
+					  workspace = data.bitbucket_workspace.testacc.uuid
+					  name      = "%s"
+					}`, workspaceSlug, groupName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      "bitbucket_group.testacc",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     fmt.Sprintf("%s/%s", workspaceSlug, groupName),
+			},
+		},
+	})
+}
+
 func TestGenerateGroupResourceId(t *testing.T) {
 	expected := "{my-workspace-uuid}-my-test-group"
 	result := generateGroupResourceId("{my-workspace-uuid}", "my-test-group")
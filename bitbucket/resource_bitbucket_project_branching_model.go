@@ -0,0 +1,124 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+func resourceBitbucketProjectBranchingModel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBitbucketProjectBranchingModelPut,
+		ReadContext:   resourceBitbucketProjectBranchingModelRead,
+		UpdateContext: resourceBitbucketProjectBranchingModelPut,
+		DeleteContext: resourceBitbucketProjectBranchingModelDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBitbucketProjectBranchingModelImport,
+		},
+		Schema: branchingModelSchema(map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the branching model.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"workspace": {
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"project_key": {
+				Description:      "The key of the project.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateProjectKey,
+			},
+		}),
+	}
+}
+
+func resourceBitbucketProjectBranchingModelPut(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	_, err := client.BranchingModels.UpdateProjectSettings(
+		&v2.BranchingModelOptions{
+			Owner:      resourceData.Get("workspace").(string),
+			ProjectKey: resourceData.Get("project_key").(string),
+			Settings:   expandBranchingModelSettings(resourceData),
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to update project branching model with error: %s", err))
+	}
+
+	resourceData.SetId(fmt.Sprintf("%s/%s", resourceData.Get("workspace").(string), resourceData.Get("project_key").(string)))
+
+	return resourceBitbucketProjectBranchingModelRead(ctx, resourceData, meta)
+}
+
+func resourceBitbucketProjectBranchingModelRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	settings, err := client.BranchingModels.GetProjectSettings(
+		&v2.BranchingModelOptions{
+			Owner:      resourceData.Get("workspace").(string),
+			ProjectKey: resourceData.Get("project_key").(string),
+		},
+	)
+	if err != nil {
+		// If the project has been deleted outside of Terraform, its branching model goes with it, so we remove it from
+		// state so that it will be re-created.
+		if v2.IsNotFound(err) && resourceData.Id() != "" {
+			resourceData.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(fmt.Errorf("unable to get project branching model with error: %s", err))
+	}
+
+	flattenBranchingModelSettings(resourceData, settings)
+
+	return nil
+}
+
+func resourceBitbucketProjectBranchingModelDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	// A branching model cannot be deleted, so we return it to the settings Bitbucket gives every new project.
+	_, err := client.BranchingModels.UpdateProjectSettings(
+		&v2.BranchingModelOptions{
+			Owner:      resourceData.Get("workspace").(string),
+			ProjectKey: resourceData.Get("project_key").(string),
+			Settings:   defaultBranchingModelSettings(),
+		},
+	)
+	if err != nil && !v2.IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("unable to reset project branching model with error: %s", err))
+	}
+
+	resourceData.SetId("")
+
+	return nil
+}
+
+func resourceBitbucketProjectBranchingModelImport(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ret := []*schema.ResourceData{resourceData}
+
+	splitID := strings.Split(resourceData.Id(), "/")
+	if len(splitID) < 2 {
+		return ret, fmt.Errorf("invalid import ID. It must to be in this format \"<workspace-slug|workspace-uuid>/<project-key>\"")
+	}
+
+	_ = resourceData.Set("workspace", splitID[0])
+	_ = resourceData.Set("project_key", splitID[1])
+
+	_ = resourceBitbucketProjectBranchingModelRead(ctx, resourceData, meta)
+
+	return ret, nil
+}
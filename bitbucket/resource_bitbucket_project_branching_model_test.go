@@ -0,0 +1,82 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBitbucketProjectBranchingModelResource_basic(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	projectName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectKey := strings.ToUpper(acctest.RandStringFromCharSet(3, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_project" "testacc" {
+					  workspace = data.bitbucket_workspace.testacc.id
+					  name      = "%s"
+					  key       = "%s"
+					}
+
+					resource "bitbucket_project_branching_model" "testacc" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  project_key = bitbucket_project.testacc.key
+
+					  development {
+					    name = "develop"
+					  }
+
+					  production {
+					    use_mainbranch = true
+					  }
+
+					  branch_type {
+					    kind   = "feature"
+					    prefix = "feature/"
+					  }
+
+					  branch_type {
+					    kind   = "release"
+					    prefix = "release/"
+					  }
+					}`, workspaceSlug, projectName, projectKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_project_branching_model.testacc", "id", fmt.Sprintf("%s/%s", workspaceSlug, projectKey)),
+					resource.TestCheckResourceAttr("bitbucket_project_branching_model.testacc", "workspace", workspaceSlug),
+					resource.TestCheckResourceAttr("bitbucket_project_branching_model.testacc", "project_key", projectKey),
+					resource.TestCheckResourceAttr("bitbucket_project_branching_model.testacc", "development.0.name", "develop"),
+					resource.TestCheckResourceAttr("bitbucket_project_branching_model.testacc", "development.0.use_mainbranch", "false"),
+					resource.TestCheckResourceAttr("bitbucket_project_branching_model.testacc", "production.0.use_mainbranch", "true"),
+					resource.TestCheckResourceAttr("bitbucket_project_branching_model.testacc", "branch_type.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("bitbucket_project_branching_model.testacc", "branch_type.*", map[string]string{
+						"kind":   "feature",
+						"prefix": "feature/",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("bitbucket_project_branching_model.testacc", "branch_type.*", map[string]string{
+						"kind":   "release",
+						"prefix": "release/",
+					}),
+				),
+			},
+			{
+				ResourceName:      "bitbucket_project_branching_model.testacc",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", workspaceSlug, projectKey),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
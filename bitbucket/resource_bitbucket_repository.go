@@ -6,12 +6,17 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	gobb "github.com/ktrysmt/go-bitbucket"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
 )
 
 func resourceBitbucketRepository() *schema.Resource {
@@ -23,6 +28,12 @@ func resourceBitbucketRepository() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceBitbucketRepositoryImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		CustomizeDiff: customdiff.ComputedIf("slug", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+			return diff.HasChange("name")
+		}),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Description: "The UUID of the repository.",
@@ -35,10 +46,15 @@ func resourceBitbucketRepository() *schema.Resource {
 				Required:    true,
 			},
 			"name": {
-				Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores, hyphens and periods).",
-				Type:             schema.TypeString,
-				Required:         true,
-				ValidateDiagFunc: validateRepositoryName,
+				Description:  "The name of the repository, from which Bitbucket derives its slug.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"slug": {
+				Description: "The slug of the repository, used to refer to it in URLs.",
+				Type:        schema.TypeString,
+				Computed:    true,
 			},
 			"project_key": {
 				Description:      "The key of the project this repository belongs to.",
@@ -64,6 +80,18 @@ func resourceBitbucketRepository() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+			"has_issues": {
+				Description: "A boolean to state if the repository includes an issue tracker or not. If omitted, the repository's current setting is left as is.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"main_branch": {
+				Description: "The name of the repository's main branch. If omitted, Bitbucket's default is used.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
 			"fork_policy": {
 				Description:  "The name of the fork policy to apply to this repository.",
 				Type:         schema.TypeString,
@@ -86,6 +114,30 @@ func resourceBitbucketRepository() *schema.Resource {
 				Optional:    true,
 				Default:     "",
 			},
+			"fork_from": {
+				Description: "The repository to create this repository as a fork of. If omitted, the repository an existing fork was created from is still read back.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"workspace": {
+							Description: "The slug or UUID (including the enclosing `{}`) of the workspace the repository to fork belongs to.",
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+						},
+						"repository": {
+							Description: "The name of the repository to fork.",
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -93,28 +145,33 @@ func resourceBitbucketRepository() *schema.Resource {
 func resourceBitbucketRepositoryCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Clients).V2
 
-	repository, err := client.Repositories.Repository.Create(
-		&gobb.RepositoryOptions{
-			Owner:       resourceData.Get("workspace").(string),
-			RepoSlug:    resourceData.Get("name").(string),
-			Description: resourceData.Get("description").(string),
-			Project:     resourceData.Get("project_key").(string),
-			IsPrivate:   strconv.FormatBool(resourceData.Get("is_private").(bool)),
-			HasWiki:     strconv.FormatBool(resourceData.Get("has_wiki").(bool)),
-			ForkPolicy:  resourceData.Get("fork_policy").(string),
-			Language:    resourceData.Get("language").(string),
+	if forkFrom := resourceData.Get("fork_from").([]interface{}); len(forkFrom) > 0 && forkFrom[0] != nil {
+		return resourceBitbucketRepositoryFork(ctx, resourceData, meta, forkFrom[0].(map[string]interface{}))
+	}
+
+	repository, err := meta.(*Clients).V2Ext.Repositories.Create(
+		&v2.RepositoryOptions{
+			Owner:      resourceData.Get("workspace").(string),
+			RepoSlug:   repositorySlug(resourceData.Get("name").(string)),
+			Repository: expandRepository(resourceData),
 		},
 	)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to create repository with error: %s", err))
 	}
 
-	resourceData.SetId(repository.Uuid)
+	resourceData.SetId(repository.UUID)
+
+	if mainBranch, ok := resourceData.GetOk("main_branch"); ok {
+		if err := updateRepositoryMainBranch(resourceData, meta.(*Clients).V2Ext, mainBranch.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	_, err = client.Repositories.Repository.UpdatePipelineConfig(
 		&gobb.RepositoryPipelineOptions{
 			Owner:    resourceData.Get("workspace").(string),
-			RepoSlug: resourceData.Get("name").(string),
+			RepoSlug: resourceData.Id(),
 			Enabled:  resourceData.Get("enable_pipelines").(bool),
 		},
 	)
@@ -125,32 +182,94 @@ func resourceBitbucketRepositoryCreate(ctx context.Context, resourceData *schema
 	return resourceBitbucketRepositoryRead(ctx, resourceData, meta)
 }
 
+// resourceBitbucketRepositoryFork creates the repository as a fork, waits for Bitbucket to finish copying it & then
+// applies the rest of the configuration to it.
+func resourceBitbucketRepositoryFork(ctx context.Context, resourceData *schema.ResourceData, meta interface{}, forkFrom map[string]interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2
+
+	repository, err := client.Repositories.Repository.Fork(
+		&gobb.RepositoryForkOptions{
+			FromOwner: forkFrom["workspace"].(string),
+			FromSlug:  forkFrom["repository"].(string),
+			Owner:     resourceData.Get("workspace").(string),
+			Name:      resourceData.Get("name").(string),
+			Project:   resourceData.Get("project_key").(string),
+			IsPrivate: strconv.FormatBool(resourceData.Get("is_private").(bool)),
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to fork repository with error: %s", err))
+	}
+
+	resourceData.SetId(repository.Uuid)
+
+	parent, err := client.Repositories.Repository.Get(
+		&gobb.RepositoryOptions{
+			Owner:    forkFrom["workspace"].(string),
+			RepoSlug: forkFrom["repository"].(string),
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to get forked repository with error: %s", err))
+	}
+
+	// A fork is created in the background; until it completes, the repository has no main branch. An empty repository
+	// has no main branch to copy though, so its fork is ready as soon as Bitbucket returns it.
+	err = retry.RetryContext(ctx, resourceData.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		fork, err := client.Repositories.Repository.Get(
+			&gobb.RepositoryOptions{
+				Owner:    resourceData.Get("workspace").(string),
+				RepoSlug: resourceData.Id(),
+			},
+		)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if !isRepositoryForkReady(fork, parent) {
+			return retry.RetryableError(fmt.Errorf("repository fork is still in progress"))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to wait for repository fork to complete with error: %s", err))
+	}
+
+	return resourceBitbucketRepositoryUpdate(ctx, resourceData, meta)
+}
+
 func resourceBitbucketRepositoryRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Clients).V2
 
 	repository, err := client.Repositories.Repository.Get(
 		&gobb.RepositoryOptions{
 			Owner:    resourceData.Get("workspace").(string),
-			RepoSlug: resourceData.Get("name").(string),
+			RepoSlug: repositoryLookupSlug(resourceData),
 		},
 	)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to get repository with error: %s", err))
 	}
 
+	_ = resourceData.Set("name", repository.Name)
+	_ = resourceData.Set("slug", repository.Slug)
 	_ = resourceData.Set("description", repository.Description)
 	_ = resourceData.Set("project_key", repository.Project.Key)
 	_ = resourceData.Set("is_private", repository.Is_private)
 	_ = resourceData.Set("has_wiki", repository.Has_wiki)
+	_ = resourceData.Set("has_issues", repository.Has_issues)
+	_ = resourceData.Set("main_branch", repository.Mainbranch.Name)
 	_ = resourceData.Set("fork_policy", repository.Fork_policy)
 	_ = resourceData.Set("language", repository.Language)
+	_ = resourceData.Set("fork_from", flattenRepositoryForkFrom(repository.Parent, resourceData.Get("fork_from").([]interface{})))
 
 	resourceData.SetId(repository.Uuid)
 
 	repositoryPipelineConfig, err := client.Repositories.Repository.GetPipelineConfig(
 		&gobb.RepositoryPipelineOptions{
 			Owner:    resourceData.Get("workspace").(string),
-			RepoSlug: resourceData.Get("name").(string),
+			RepoSlug: resourceData.Id(),
 		},
 	)
 	if err != nil {
@@ -172,17 +291,21 @@ func resourceBitbucketRepositoryRead(ctx context.Context, resourceData *schema.R
 func resourceBitbucketRepositoryUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Clients).V2
 
-	_, err := client.Repositories.Repository.Update(
-		&gobb.RepositoryOptions{
-			Uuid:        resourceData.Id(),
-			Owner:       resourceData.Get("workspace").(string),
-			RepoSlug:    resourceData.Get("name").(string),
-			Description: resourceData.Get("description").(string),
-			Project:     resourceData.Get("project_key").(string),
-			IsPrivate:   strconv.FormatBool(resourceData.Get("is_private").(bool)),
-			HasWiki:     strconv.FormatBool(resourceData.Get("has_wiki").(bool)),
-			ForkPolicy:  resourceData.Get("fork_policy").(string),
-			Language:    resourceData.Get("language").(string),
+	// The repository is addressed by its UUID, so that it can be renamed (which changes its slug) in place.
+	repository := expandRepository(resourceData)
+
+	if mainBranch := resourceData.Get("main_branch").(string); resourceData.HasChange("main_branch") && mainBranch != "" {
+		repository.Mainbranch = &v2.RepositoryBranch{
+			Name: mainBranch,
+			Type: "branch",
+		}
+	}
+
+	_, err := meta.(*Clients).V2Ext.Repositories.Update(
+		&v2.RepositoryOptions{
+			Owner:      resourceData.Get("workspace").(string),
+			RepoSlug:   resourceData.Id(),
+			Repository: repository,
 		},
 	)
 	if err != nil {
@@ -192,7 +315,7 @@ func resourceBitbucketRepositoryUpdate(ctx context.Context, resourceData *schema
 	_, err = client.Repositories.Repository.UpdatePipelineConfig(
 		&gobb.RepositoryPipelineOptions{
 			Owner:    resourceData.Get("workspace").(string),
-			RepoSlug: resourceData.Get("name").(string),
+			RepoSlug: resourceData.Id(),
 			Enabled:  resourceData.Get("enable_pipelines").(bool),
 		},
 	)
@@ -209,7 +332,7 @@ func resourceBitbucketRepositoryDelete(ctx context.Context, resourceData *schema
 	_, err := client.Repositories.Repository.Delete(
 		&gobb.RepositoryOptions{
 			Owner:    resourceData.Get("workspace").(string),
-			RepoSlug: resourceData.Get("name").(string),
+			RepoSlug: resourceData.Id(),
 		},
 	)
 	if err != nil {
@@ -226,17 +349,136 @@ func resourceBitbucketRepositoryImport(ctx context.Context, resourceData *schema
 
 	splitID := strings.Split(resourceData.Id(), "/")
 	if len(splitID) < 2 {
-		return ret, fmt.Errorf("invalid import ID. It must to be in this format \"<workspace-slug|workspace-uuid>/<repository-name>\"")
+		return ret, fmt.Errorf("invalid import ID. It must to be in this format \"<workspace-slug|workspace-uuid>/<repository-slug>\"")
 	}
 
+	// Until it is read, the repository is addressed by its slug, after which its UUID is used.
 	_ = resourceData.Set("workspace", splitID[0])
-	_ = resourceData.Set("name", splitID[1])
+	resourceData.SetId(splitID[1])
 
 	_ = resourceBitbucketRepositoryRead(ctx, resourceData, meta)
 
 	return ret, nil
 }
 
+// repositoryLookupSlug returns the UUID of the repository once it is known, so that it can still be found after being
+// renamed outside of Terraform. Until then, it is the slug the repository is being imported by, or for the data
+// source, the slug it is configured with as its name.
+func repositoryLookupSlug(resourceData *schema.ResourceData) string {
+	if resourceData.Id() != "" {
+		return resourceData.Id()
+	}
+
+	return resourceData.Get("name").(string)
+}
+
+func expandRepository(resourceData *schema.ResourceData) *v2.Repository {
+	description := resourceData.Get("description").(string)
+	isPrivate := resourceData.Get("is_private").(bool)
+	hasWiki := resourceData.Get("has_wiki").(bool)
+	language := resourceData.Get("language").(string)
+
+	repository := &v2.Repository{
+		Name:        resourceData.Get("name").(string),
+		Description: &description,
+		IsPrivate:   &isPrivate,
+		HasWiki:     &hasWiki,
+		Language:    &language,
+		Project: &v2.RepositoryProject{
+			Key: resourceData.Get("project_key").(string),
+		},
+	}
+
+	if isConfigured(resourceData, "has_issues") {
+		hasIssues := resourceData.Get("has_issues").(bool)
+		repository.HasIssues = &hasIssues
+	}
+
+	// Bitbucket only applies a change of fork policy when the flags it is derived from are sent alongside it.
+	// See: https://jira.atlassian.com/browse/BCLOUD-13093
+	repository.ForkPolicy = resourceData.Get("fork_policy").(string)
+	noForks := repository.ForkPolicy == "no_forks"
+	noPublicForks := repository.ForkPolicy != "allow_forks"
+	repository.NoForks = &noForks
+	repository.NoPublicForks = &noPublicForks
+
+	return repository
+}
+
+var invalidRepositorySlugCharacters = regexp.MustCompile(`[^a-z0-9._-]+`)
+
+// repositorySlug derives a repository's slug from its name in the same way Bitbucket does, e.g. "My Repo" becomes
+// "my-repo".
+func repositorySlug(name string) string {
+	return strings.Trim(invalidRepositorySlugCharacters.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// isRepositoryForkReady reports whether Bitbucket has finished copying the given parent repository into its fork.
+func isRepositoryForkReady(fork *gobb.Repository, parent *gobb.Repository) bool {
+	return fork.Mainbranch.Name != "" || parent.Mainbranch.Name == ""
+}
+
+// isConfigured reports whether the given attribute is set in the configuration, which for an Optional & Computed
+// attribute tells setting it to its zero value apart from omitting it.
+func isConfigured(resourceData *schema.ResourceData, key string) bool {
+	config := resourceData.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+
+	return !config.GetAttr(key).IsNull()
+}
+
+// flattenRepositoryForkFrom returns the repository the given parent refers to. Where the configured values refer to the
+// same repository, by slug or UUID, they are kept so the two forms don't produce a diff.
+func flattenRepositoryForkFrom(parent *gobb.Repository, configured []interface{}) []interface{} {
+	if parent == nil {
+		return nil
+	}
+
+	workspace := strings.Split(parent.Full_name, "/")[0]
+	repository := parent.Slug
+
+	if len(configured) > 0 && configured[0] != nil {
+		configuredForkFrom := configured[0].(map[string]interface{})
+
+		if configuredWorkspace := configuredForkFrom["workspace"].(string); strings.EqualFold(configuredWorkspace, workspace) || configuredWorkspace == parent.Owner["uuid"] {
+			workspace = configuredWorkspace
+		}
+		if configuredRepository := configuredForkFrom["repository"].(string); strings.EqualFold(configuredRepository, repository) || configuredRepository == parent.Uuid {
+			repository = configuredRepository
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"workspace":  workspace,
+			"repository": repository,
+		},
+	}
+}
+
+// updateRepositoryMainBranch sets the repository's main branch, which go-bitbucket is unable to do.
+func updateRepositoryMainBranch(resourceData *schema.ResourceData, client *v2.Client, mainBranch string) error {
+	_, err := client.Repositories.Update(
+		&v2.RepositoryOptions{
+			Owner:    resourceData.Get("workspace").(string),
+			RepoSlug: resourceData.Id(),
+			Repository: &v2.Repository{
+				Mainbranch: &v2.RepositoryBranch{
+					Name: mainBranch,
+					Type: "branch",
+				},
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to update main branch for repository with error: %s", err)
+	}
+
+	return nil
+}
+
 func validateRepositoryName(val interface{}, path cty.Path) diag.Diagnostics {
 	match, _ := regexp.MatchString("^[a-z0-9\\._-]+$", val.(string))
 	if !match {
@@ -0,0 +1,304 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+// The branch types Bitbucket supports, along with the prefix it uses for each by default.
+var branchingModelDefaultBranchTypes = map[string]string{
+	"bugfix":  "bugfix/",
+	"feature": "feature/",
+	"hotfix":  "hotfix/",
+	"release": "release/",
+}
+
+func resourceBitbucketRepositoryBranchingModel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBitbucketRepositoryBranchingModelPut,
+		ReadContext:   resourceBitbucketRepositoryBranchingModelRead,
+		UpdateContext: resourceBitbucketRepositoryBranchingModelPut,
+		DeleteContext: resourceBitbucketRepositoryBranchingModelDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBitbucketRepositoryBranchingModelImport,
+		},
+		Schema: branchingModelSchema(map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the branching model.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"workspace": {
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"repository": {
+				Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateRepositoryName,
+			},
+		}),
+	}
+}
+
+func resourceBitbucketRepositoryBranchingModelPut(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	_, err := client.BranchingModels.UpdateRepositorySettings(
+		&v2.BranchingModelOptions{
+			Owner:    resourceData.Get("workspace").(string),
+			RepoSlug: resourceData.Get("repository").(string),
+			Settings: expandBranchingModelSettings(resourceData),
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to update repository branching model with error: %s", err))
+	}
+
+	resourceData.SetId(fmt.Sprintf("%s/%s", resourceData.Get("workspace").(string), resourceData.Get("repository").(string)))
+
+	return resourceBitbucketRepositoryBranchingModelRead(ctx, resourceData, meta)
+}
+
+func resourceBitbucketRepositoryBranchingModelRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	settings, err := client.BranchingModels.GetRepositorySettings(
+		&v2.BranchingModelOptions{
+			Owner:    resourceData.Get("workspace").(string),
+			RepoSlug: resourceData.Get("repository").(string),
+		},
+	)
+	if err != nil {
+		// If the repository has been deleted outside of Terraform, its branching model goes with it, so we remove it from
+		// state so that it will be re-created.
+		if v2.IsNotFound(err) && resourceData.Id() != "" {
+			resourceData.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(fmt.Errorf("unable to get repository branching model with error: %s", err))
+	}
+
+	flattenBranchingModelSettings(resourceData, settings)
+
+	return nil
+}
+
+func resourceBitbucketRepositoryBranchingModelDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	// A branching model cannot be deleted, so we return it to the settings Bitbucket gives every new repository.
+	_, err := client.BranchingModels.UpdateRepositorySettings(
+		&v2.BranchingModelOptions{
+			Owner:    resourceData.Get("workspace").(string),
+			RepoSlug: resourceData.Get("repository").(string),
+			Settings: defaultBranchingModelSettings(),
+		},
+	)
+	if err != nil && !v2.IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("unable to reset repository branching model with error: %s", err))
+	}
+
+	resourceData.SetId("")
+
+	return nil
+}
+
+func resourceBitbucketRepositoryBranchingModelImport(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ret := []*schema.ResourceData{resourceData}
+
+	splitID := strings.Split(resourceData.Id(), "/")
+	if len(splitID) < 2 {
+		return ret, fmt.Errorf("invalid import ID. It must to be in this format \"<workspace-slug|workspace-uuid>/<repository-name>\"")
+	}
+
+	_ = resourceData.Set("workspace", splitID[0])
+	_ = resourceData.Set("repository", splitID[1])
+
+	_ = resourceBitbucketRepositoryBranchingModelRead(ctx, resourceData, meta)
+
+	return ret, nil
+}
+
+// branchingModelSchema returns the settings shared by repository & project branching models, merged with the given
+// schema which identifies the owner of the branching model.
+func branchingModelSchema(ownerSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	branchSchema := func(description string, required bool) *schema.Schema {
+		return &schema.Schema{
+			Description: description,
+			Type:        schema.TypeList,
+			Required:    required,
+			Optional:    !required,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The name of the branch. If `use_mainbranch` is true, this will be the name of the repository's main branch.",
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+					},
+					"use_mainbranch": {
+						Description: "A boolean to state if the repository's main branch should be used, instead of the branch set by `name`.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		}
+	}
+
+	settingsSchema := map[string]*schema.Schema{
+		"development": branchSchema("The development branch, which is the target of pull requests for feature & bugfix branches.", true),
+		"production":  branchSchema("The production branch, which is the target of pull requests for hotfix branches. If omitted, the production branch is disabled.", false),
+		"branch_type": {
+			Description: "A branch type to enable. Branch types that are not listed are disabled.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"kind": {
+						Description:  "The kind of branch. Must be one of 'feature', 'bugfix', 'release', 'hotfix'.",
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"feature", "bugfix", "release", "hotfix"}, false),
+					},
+					"prefix": {
+						Description: "The prefix for branches of this kind (e.g. `feature/`).",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+				},
+			},
+		},
+	}
+
+	for key, value := range ownerSchema {
+		settingsSchema[key] = value
+	}
+
+	return settingsSchema
+}
+
+func expandBranchingModelSettings(resourceData *schema.ResourceData) *v2.BranchingModelSettings {
+	settings := &v2.BranchingModelSettings{
+		Production: v2.BranchingModelBranch{Enabled: new(bool)},
+	}
+
+	if development := resourceData.Get("development").([]interface{}); len(development) > 0 && development[0] != nil {
+		settings.Development = expandBranchingModelBranch(development[0].(map[string]interface{}))
+	}
+
+	if production := resourceData.Get("production").([]interface{}); len(production) > 0 && production[0] != nil {
+		settings.Production = expandBranchingModelBranch(production[0].(map[string]interface{}))
+		enabled := true
+		settings.Production.Enabled = &enabled
+	}
+
+	settings.BranchTypes = expandBranchingModelBranchTypes(resourceData.Get("branch_type").(*schema.Set).List())
+
+	return settings
+}
+
+func expandBranchingModelBranch(branch map[string]interface{}) v2.BranchingModelBranch {
+	expanded := v2.BranchingModelBranch{
+		UseMainbranch: branch["use_mainbranch"].(bool),
+	}
+	if !expanded.UseMainbranch {
+		expanded.Name = branch["name"].(string)
+	}
+
+	return expanded
+}
+
+// expandBranchingModelBranchTypes always returns every kind of branch type, so those not configured get disabled.
+func expandBranchingModelBranchTypes(branchTypes []interface{}) []v2.BranchingModelBranchType {
+	configured := make(map[string]string)
+	for _, branchType := range branchTypes {
+		branchType := branchType.(map[string]interface{})
+		configured[branchType["kind"].(string)] = branchType["prefix"].(string)
+	}
+
+	var expanded []v2.BranchingModelBranchType
+	for _, kind := range sortedBranchingModelKinds() {
+		prefix, enabled := configured[kind]
+		expanded = append(expanded, v2.BranchingModelBranchType{
+			Kind:    kind,
+			Prefix:  prefix,
+			Enabled: enabled,
+		})
+	}
+
+	return expanded
+}
+
+func flattenBranchingModelSettings(resourceData *schema.ResourceData, settings *v2.BranchingModelSettings) {
+	_ = resourceData.Set("development", flattenBranchingModelBranch(settings.Development))
+
+	if settings.Production.Enabled != nil && *settings.Production.Enabled {
+		_ = resourceData.Set("production", flattenBranchingModelBranch(settings.Production))
+	} else {
+		_ = resourceData.Set("production", nil)
+	}
+
+	var branchTypes []interface{}
+	for _, branchType := range settings.BranchTypes {
+		if branchType.Enabled {
+			branchTypes = append(branchTypes, map[string]interface{}{
+				"kind":   branchType.Kind,
+				"prefix": branchType.Prefix,
+			})
+		}
+	}
+	_ = resourceData.Set("branch_type", branchTypes)
+}
+
+func flattenBranchingModelBranch(branch v2.BranchingModelBranch) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"name":           branch.Name,
+			"use_mainbranch": branch.UseMainbranch,
+		},
+	}
+}
+
+func defaultBranchingModelSettings() *v2.BranchingModelSettings {
+	settings := &v2.BranchingModelSettings{
+		Development: v2.BranchingModelBranch{UseMainbranch: true},
+		Production:  v2.BranchingModelBranch{Enabled: new(bool)},
+	}
+
+	for _, kind := range sortedBranchingModelKinds() {
+		settings.BranchTypes = append(settings.BranchTypes, v2.BranchingModelBranchType{
+			Kind:    kind,
+			Prefix:  branchingModelDefaultBranchTypes[kind],
+			Enabled: true,
+		})
+	}
+
+	return settings
+}
+
+func sortedBranchingModelKinds() []string {
+	var kinds []string
+	for kind := range branchingModelDefaultBranchTypes {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	return kinds
+}
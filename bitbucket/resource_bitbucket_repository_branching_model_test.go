@@ -0,0 +1,150 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+func TestAccBitbucketRepositoryBranchingModelResource_basic(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	projectName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectKey := strings.ToUpper(acctest.RandStringFromCharSet(3, acctest.CharSetAlpha))
+	repoName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_project" "testacc" {
+					  workspace = data.bitbucket_workspace.testacc.id
+					  name      = "%s"
+					  key       = "%s"
+					}
+
+					resource "bitbucket_repository" "testacc" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  project_key = bitbucket_project.testacc.key
+					  name        = "%s"
+					}
+
+					resource "bitbucket_repository_branching_model" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.name
+
+					  development {
+					    use_mainbranch = true
+					  }
+
+					  branch_type {
+					    kind   = "feature"
+					    prefix = "feature/"
+					  }
+
+					  branch_type {
+					    kind   = "release"
+					    prefix = "release/"
+					  }
+					}`, workspaceSlug, projectName, projectKey, repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_repository_branching_model.testacc", "id", fmt.Sprintf("%s/%s", workspaceSlug, repoName)),
+					resource.TestCheckResourceAttr("bitbucket_repository_branching_model.testacc", "workspace", workspaceSlug),
+					resource.TestCheckResourceAttr("bitbucket_repository_branching_model.testacc", "repository", repoName),
+					resource.TestCheckResourceAttr("bitbucket_repository_branching_model.testacc", "development.#", "1"),
+					resource.TestCheckResourceAttr("bitbucket_repository_branching_model.testacc", "development.0.use_mainbranch", "true"),
+					resource.TestCheckResourceAttr("bitbucket_repository_branching_model.testacc", "production.#", "0"),
+					resource.TestCheckResourceAttr("bitbucket_repository_branching_model.testacc", "branch_type.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("bitbucket_repository_branching_model.testacc", "branch_type.*", map[string]string{
+						"kind":   "feature",
+						"prefix": "feature/",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("bitbucket_repository_branching_model.testacc", "branch_type.*", map[string]string{
+						"kind":   "release",
+						"prefix": "release/",
+					}),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_project" "testacc" {
+					  workspace = data.bitbucket_workspace.testacc.id
+					  name      = "%s"
+					  key       = "%s"
+					}
+
+					resource "bitbucket_repository" "testacc" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  project_key = bitbucket_project.testacc.key
+					  name        = "%s"
+					}
+
+					resource "bitbucket_repository_branching_model" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.name
+
+					  development {
+					    name = "develop"
+					  }
+
+					  production {
+					    use_mainbranch = true
+					  }
+
+					  branch_type {
+					    kind   = "hotfix"
+					    prefix = "hotfix/"
+					  }
+					}`, workspaceSlug, projectName, projectKey, repoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_repository_branching_model.testacc", "development.0.name", "develop"),
+					resource.TestCheckResourceAttr("bitbucket_repository_branching_model.testacc", "development.0.use_mainbranch", "false"),
+					resource.TestCheckResourceAttr("bitbucket_repository_branching_model.testacc", "production.#", "1"),
+					resource.TestCheckResourceAttr("bitbucket_repository_branching_model.testacc", "production.0.use_mainbranch", "true"),
+					resource.TestCheckResourceAttr("bitbucket_repository_branching_model.testacc", "branch_type.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("bitbucket_repository_branching_model.testacc", "branch_type.*", map[string]string{
+						"kind":   "hotfix",
+						"prefix": "hotfix/",
+					}),
+				),
+			},
+			{
+				ResourceName:      "bitbucket_repository_branching_model.testacc",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", workspaceSlug, repoName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestExpandBranchingModelBranchTypes(t *testing.T) {
+	branchTypes := []interface{}{
+		map[string]interface{}{"kind": "release", "prefix": "rel/"},
+		map[string]interface{}{"kind": "feature", "prefix": "feat/"},
+	}
+
+	expected := []v2.BranchingModelBranchType{
+		{Kind: "bugfix", Prefix: "", Enabled: false},
+		{Kind: "feature", Prefix: "feat/", Enabled: true},
+		{Kind: "hotfix", Prefix: "", Enabled: false},
+		{Kind: "release", Prefix: "rel/", Enabled: true},
+	}
+	assert.Equal(t, expected, expandBranchingModelBranchTypes(branchTypes))
+}
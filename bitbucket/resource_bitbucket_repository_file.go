@@ -0,0 +1,205 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gobb "github.com/ktrysmt/go-bitbucket"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+func resourceBitbucketRepositoryFile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBitbucketRepositoryFilePut,
+		ReadContext:   resourceBitbucketRepositoryFileRead,
+		UpdateContext: resourceBitbucketRepositoryFilePut,
+		DeleteContext: resourceBitbucketRepositoryFileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBitbucketRepositoryFileImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the repository file.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"workspace": {
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"repository": {
+				Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateRepositoryName,
+			},
+			"branch": {
+				Description: "The name of the branch to commit the file to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"path": {
+				Description: "The path of the file within the repository.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				StateFunc: func(val interface{}) string {
+					return strings.TrimPrefix(val.(string), "/")
+				},
+			},
+			"content": {
+				Description: "The content of the file.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"commit_message": {
+				Description: "The message of the commits made to create, update & delete the file.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Managed by Terraform",
+			},
+			"commit_author": {
+				Description:  "The author of the commits made to create, update & delete the file, in the format `Name <email>`. If omitted, the authenticated user is used.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^<>]+ <[^<>]+>$`), "must be in the format `Name <email>`"),
+			},
+		},
+	}
+}
+
+func resourceBitbucketRepositoryFilePut(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	// The commit's message & author only apply to the commits made from now on, so changing just those has nothing to
+	// commit.
+	if !resourceData.IsNewResource() && !resourceData.HasChange("content") {
+		return resourceBitbucketRepositoryFileRead(ctx, resourceData, meta)
+	}
+
+	path := strings.TrimPrefix(resourceData.Get("path").(string), "/")
+
+	err := client.Source.Commit(
+		&v2.SourceCommitOptions{
+			Owner:    resourceData.Get("workspace").(string),
+			RepoSlug: resourceData.Get("repository").(string),
+			Branch:   resourceData.Get("branch").(string),
+			Message:  resourceData.Get("commit_message").(string),
+			Author:   resourceData.Get("commit_author").(string),
+			Files: map[string]string{
+				path: resourceData.Get("content").(string),
+			},
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to commit repository file with error: %s", err))
+	}
+
+	resourceData.SetId(generateRepositoryFileId(
+		resourceData.Get("workspace").(string),
+		resourceData.Get("repository").(string),
+		resourceData.Get("branch").(string),
+		path,
+	))
+
+	return resourceBitbucketRepositoryFileRead(ctx, resourceData, meta)
+}
+
+func resourceBitbucketRepositoryFileRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2
+
+	// Branch names may contain slashes, which the source endpoint cannot tell apart from the file's path, so the
+	// file is read at the commit the branch currently points to.
+	branch, err := client.Repositories.Repository.GetBranch(
+		&gobb.RepositoryBranchOptions{
+			Owner:      resourceData.Get("workspace").(string),
+			RepoSlug:   resourceData.Get("repository").(string),
+			BranchName: resourceData.Get("branch").(string),
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to get branch for repository file with error: %s", err))
+	}
+
+	commit, _ := branch.Target["hash"].(string)
+
+	content, err := meta.(*Clients).V2Ext.Source.GetFileContent(
+		&v2.SourceOptions{
+			Owner:    resourceData.Get("workspace").(string),
+			RepoSlug: resourceData.Get("repository").(string),
+			Commit:   commit,
+			Path:     resourceData.Get("path").(string),
+		},
+	)
+	if err != nil {
+		// If the file has been deleted outside of Terraform, we remove it from state so that it will be re-committed.
+		if v2.IsNotFound(err) {
+			resourceData.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(fmt.Errorf("unable to get repository file with error: %s", err))
+	}
+
+	_ = resourceData.Set("content", string(content))
+
+	return nil
+}
+
+func resourceBitbucketRepositoryFileDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	err := client.Source.Commit(
+		&v2.SourceCommitOptions{
+			Owner:        resourceData.Get("workspace").(string),
+			RepoSlug:     resourceData.Get("repository").(string),
+			Branch:       resourceData.Get("branch").(string),
+			Message:      resourceData.Get("commit_message").(string),
+			Author:       resourceData.Get("commit_author").(string),
+			DeletedFiles: []string{resourceData.Get("path").(string)},
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to delete repository file with error: %s", err))
+	}
+
+	resourceData.SetId("")
+
+	return nil
+}
+
+func resourceBitbucketRepositoryFileImport(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ret := []*schema.ResourceData{resourceData}
+
+	// Git does not allow colons in branch names, so it safely separates the branch from the path.
+	splitID := strings.SplitN(resourceData.Id(), "/", 3)
+	if len(splitID) < 3 || !strings.Contains(splitID[2], ":") {
+		return ret, fmt.Errorf("invalid import ID. It must to be in this format \"<workspace-slug|workspace-uuid>/<repository-name>/<branch>:<path>\"")
+	}
+
+	splitRef := strings.SplitN(splitID[2], ":", 2)
+
+	_ = resourceData.Set("workspace", splitID[0])
+	_ = resourceData.Set("repository", splitID[1])
+	_ = resourceData.Set("branch", splitRef[0])
+	_ = resourceData.Set("path", strings.TrimPrefix(splitRef[1], "/"))
+	_ = resourceData.Set("commit_message", "Managed by Terraform")
+
+	_ = resourceBitbucketRepositoryFileRead(ctx, resourceData, meta)
+
+	return ret, nil
+}
+
+func generateRepositoryFileId(workspace string, repository string, branch string, path string) string {
+	return fmt.Sprintf("%s/%s/%s:%s", workspace, repository, branch, path)
+}
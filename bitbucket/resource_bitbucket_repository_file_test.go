@@ -0,0 +1,80 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBitbucketRepositoryFileResource_basic(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	projectName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectKey := strings.ToUpper(acctest.RandStringFromCharSet(3, acctest.CharSetAlpha))
+	repoName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	config := func(content string) string {
+		return fmt.Sprintf(`
+			data "bitbucket_workspace" "testacc" {
+				id = "%s"
+			}
+
+			resource "bitbucket_project" "testacc" {
+			  workspace = data.bitbucket_workspace.testacc.id
+			  name      = "%s"
+			  key       = "%s"
+			}
+
+			resource "bitbucket_repository" "testacc" {
+			  workspace   = data.bitbucket_workspace.testacc.id
+			  project_key = bitbucket_project.testacc.key
+			  name        = "%s"
+			}
+
+			resource "bitbucket_repository_file" "testacc" {
+			  workspace      = data.bitbucket_workspace.testacc.id
+			  repository     = bitbucket_repository.testacc.slug
+			  branch         = "master"
+			  path           = "bitbucket-pipelines.yml"
+			  content        = "%s"
+			  commit_message = "Configure pipelines"
+			  commit_author  = "Terraform <terraform@example.com>"
+			}`, workspaceSlug, projectName, projectKey, repoName, content)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`image: atlassian/default-image:3\n`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_repository_file.testacc", "id", fmt.Sprintf("%s/%s/master:bitbucket-pipelines.yml", workspaceSlug, repoName)),
+					resource.TestCheckResourceAttr("bitbucket_repository_file.testacc", "workspace", workspaceSlug),
+					resource.TestCheckResourceAttr("bitbucket_repository_file.testacc", "repository", repoName),
+					resource.TestCheckResourceAttr("bitbucket_repository_file.testacc", "branch", "master"),
+					resource.TestCheckResourceAttr("bitbucket_repository_file.testacc", "path", "bitbucket-pipelines.yml"),
+					resource.TestCheckResourceAttr("bitbucket_repository_file.testacc", "content", "image: atlassian/default-image:3\n"),
+					resource.TestCheckResourceAttr("bitbucket_repository_file.testacc", "commit_message", "Configure pipelines"),
+					resource.TestCheckResourceAttr("bitbucket_repository_file.testacc", "commit_author", "Terraform <terraform@example.com>"),
+				),
+			},
+			{
+				Config: config(`image: atlassian/default-image:4\n`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_repository_file.testacc", "content", "image: atlassian/default-image:4\n"),
+				),
+			},
+			{
+				ResourceName:            "bitbucket_repository_file.testacc",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s/%s/master:bitbucket-pipelines.yml", workspaceSlug, repoName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"commit_message", "commit_author"},
+			},
+		},
+	})
+}
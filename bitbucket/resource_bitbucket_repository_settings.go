@@ -0,0 +1,191 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+var repositoryMergeStrategies = []string{
+	"merge_commit",
+	"squash",
+	"fast_forward",
+	"squash_fast_forward",
+	"rebase_fast_forward",
+	"rebase_merge",
+}
+
+func resourceBitbucketRepositorySettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBitbucketRepositorySettingsPut,
+		ReadContext:   resourceBitbucketRepositorySettingsRead,
+		UpdateContext: resourceBitbucketRepositorySettingsPut,
+		DeleteContext: resourceBitbucketRepositorySettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBitbucketRepositorySettingsImport,
+		},
+		CustomizeDiff: validateRepositorySettingsDefaultMergeStrategy,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the repository settings.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"workspace": {
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"repository": {
+				Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateRepositoryName,
+			},
+			"merge_strategies": {
+				Description: "The merge strategies that can be used when merging a pull request. Must be one or more of 'merge_commit', 'squash', 'fast_forward', 'squash_fast_forward', 'rebase_fast_forward', 'rebase_merge'.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(repositoryMergeStrategies, false),
+				},
+			},
+			"default_merge_strategy": {
+				Description:  "The merge strategy selected by default when merging a pull request. Must be one of `merge_strategies`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(repositoryMergeStrategies, false),
+			},
+			"delete_source_branch_after_merge": {
+				Description: "A boolean to state if new pull requests should delete their source branch once merged by default.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceBitbucketRepositorySettingsPut(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	var mergeStrategies []string
+	for _, mergeStrategy := range resourceData.Get("merge_strategies").(*schema.Set).List() {
+		mergeStrategies = append(mergeStrategies, mergeStrategy.(string))
+	}
+	sort.Strings(mergeStrategies)
+
+	_, err := client.PullRequestSettings.Update(
+		&v2.PullRequestSettingsOptions{
+			Owner:    resourceData.Get("workspace").(string),
+			RepoSlug: resourceData.Get("repository").(string),
+			Settings: &v2.PullRequestSettingsValues{
+				MergeStrategies:      mergeStrategies,
+				DefaultMergeStrategy: resourceData.Get("default_merge_strategy").(string),
+				CloseSourceBranch:    resourceData.Get("delete_source_branch_after_merge").(bool),
+			},
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to update repository settings with error: %s", err))
+	}
+
+	resourceData.SetId(fmt.Sprintf("%s/%s", resourceData.Get("workspace").(string), resourceData.Get("repository").(string)))
+
+	return resourceBitbucketRepositorySettingsRead(ctx, resourceData, meta)
+}
+
+func resourceBitbucketRepositorySettingsRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	settings, err := client.PullRequestSettings.Get(
+		&v2.PullRequestSettingsOptions{
+			Owner:    resourceData.Get("workspace").(string),
+			RepoSlug: resourceData.Get("repository").(string),
+		},
+	)
+	if err != nil {
+		// If the repository has been deleted outside of Terraform, its settings go with it, so we remove them from state
+		// so that they will be re-created along with it.
+		if v2.IsNotFound(err) && resourceData.Id() != "" {
+			resourceData.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(fmt.Errorf("unable to get repository settings with error: %s", err))
+	}
+
+	_ = resourceData.Set("merge_strategies", settings.MergeStrategies)
+	_ = resourceData.Set("default_merge_strategy", settings.DefaultMergeStrategy)
+	_ = resourceData.Set("delete_source_branch_after_merge", settings.CloseSourceBranch)
+
+	return nil
+}
+
+func resourceBitbucketRepositorySettingsDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	// These settings cannot be deleted, so we return them to the ones Bitbucket gives every new repository.
+	_, err := client.PullRequestSettings.Update(
+		&v2.PullRequestSettingsOptions{
+			Owner:    resourceData.Get("workspace").(string),
+			RepoSlug: resourceData.Get("repository").(string),
+			Settings: &v2.PullRequestSettingsValues{
+				MergeStrategies:      repositoryMergeStrategies,
+				DefaultMergeStrategy: "merge_commit",
+				CloseSourceBranch:    false,
+			},
+		},
+	)
+	if err != nil {
+		// If the repository has already been deleted, there are no settings left to reset.
+		if !v2.IsNotFound(err) {
+			return diag.FromErr(fmt.Errorf("unable to reset repository settings with error: %s", err))
+		}
+	}
+
+	resourceData.SetId("")
+
+	return nil
+}
+
+func resourceBitbucketRepositorySettingsImport(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ret := []*schema.ResourceData{resourceData}
+
+	splitID := strings.Split(resourceData.Id(), "/")
+	if len(splitID) < 2 {
+		return ret, fmt.Errorf("invalid import ID. It must to be in this format \"<workspace-slug|workspace-uuid>/<repository-name>\"")
+	}
+
+	_ = resourceData.Set("workspace", splitID[0])
+	_ = resourceData.Set("repository", splitID[1])
+
+	_ = resourceBitbucketRepositorySettingsRead(ctx, resourceData, meta)
+
+	return ret, nil
+}
+
+func validateRepositorySettingsDefaultMergeStrategy(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("merge_strategies") || !diff.NewValueKnown("default_merge_strategy") {
+		return nil
+	}
+
+	defaultMergeStrategy := diff.Get("default_merge_strategy").(string)
+
+	if !diff.Get("merge_strategies").(*schema.Set).Contains(defaultMergeStrategy) {
+		return fmt.Errorf("`default_merge_strategy` \"%s\" must be one of `merge_strategies`", defaultMergeStrategy)
+	}
+
+	return nil
+}
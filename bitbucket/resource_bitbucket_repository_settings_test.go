@@ -0,0 +1,113 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccBitbucketRepositorySettingsResource_basic(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	projectName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectKey := strings.ToUpper(acctest.RandStringFromCharSet(3, acctest.CharSetAlpha))
+	repoName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	config := func(mergeStrategies string, defaultMergeStrategy string, deleteSourceBranch bool) string {
+		return fmt.Sprintf(`
+			data "bitbucket_workspace" "testacc" {
+				id = "%s"
+			}
+
+			resource "bitbucket_project" "testacc" {
+			  workspace = data.bitbucket_workspace.testacc.id
+			  name      = "%s"
+			  key       = "%s"
+			}
+
+			resource "bitbucket_repository" "testacc" {
+			  workspace   = data.bitbucket_workspace.testacc.id
+			  project_key = bitbucket_project.testacc.key
+			  name        = "%s"
+			}
+
+			resource "bitbucket_repository_settings" "testacc" {
+			  workspace                        = data.bitbucket_workspace.testacc.id
+			  repository                       = bitbucket_repository.testacc.name
+			  merge_strategies                 = [%s]
+			  default_merge_strategy           = "%s"
+			  delete_source_branch_after_merge = %t
+			}`, workspaceSlug, projectName, projectKey, repoName, mergeStrategies, defaultMergeStrategy, deleteSourceBranch)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(`"merge_commit"`, "squash", false),
+				ExpectError: regexp.MustCompile("`default_merge_strategy` \"squash\" must be one of `merge_strategies`"),
+			},
+			{
+				Config: config(`"squash", "fast_forward"`, "squash", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_repository_settings.testacc", "id", fmt.Sprintf("%s/%s", workspaceSlug, repoName)),
+					resource.TestCheckResourceAttr("bitbucket_repository_settings.testacc", "merge_strategies.#", "2"),
+					resource.TestCheckTypeSetElemAttr("bitbucket_repository_settings.testacc", "merge_strategies.*", "squash"),
+					resource.TestCheckTypeSetElemAttr("bitbucket_repository_settings.testacc", "merge_strategies.*", "fast_forward"),
+					resource.TestCheckResourceAttr("bitbucket_repository_settings.testacc", "default_merge_strategy", "squash"),
+					resource.TestCheckResourceAttr("bitbucket_repository_settings.testacc", "delete_source_branch_after_merge", "true"),
+				),
+			},
+			{
+				Config: config(`"merge_commit"`, "merge_commit", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_repository_settings.testacc", "merge_strategies.#", "1"),
+					resource.TestCheckTypeSetElemAttr("bitbucket_repository_settings.testacc", "merge_strategies.*", "merge_commit"),
+					resource.TestCheckResourceAttr("bitbucket_repository_settings.testacc", "default_merge_strategy", "merge_commit"),
+					resource.TestCheckResourceAttr("bitbucket_repository_settings.testacc", "delete_source_branch_after_merge", "false"),
+				),
+			},
+			{
+				ResourceName:      "bitbucket_repository_settings.testacc",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", workspaceSlug, repoName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceBitbucketRepositorySettingsNotFound(t *testing.T) {
+	clients := newTestClients(t, func(writer http.ResponseWriter, request *http.Request) {
+		assert.Equal(t, "/internal/repositories/workspace/repository/pullrequest-settings", request.URL.EscapedPath())
+
+		writer.WriteHeader(http.StatusNotFound)
+	})
+	newResourceData := func() *schema.ResourceData {
+		resourceData := schema.TestResourceDataRaw(t, resourceBitbucketRepositorySettings().Schema, map[string]interface{}{
+			"workspace":  "workspace",
+			"repository": "repository",
+		})
+		resourceData.SetId("workspace/repository")
+
+		return resourceData
+	}
+
+	// The repository has been deleted outside of Terraform, so its settings are removed from state
+	resourceData := newResourceData()
+	assert.Empty(t, resourceBitbucketRepositorySettingsRead(context.Background(), resourceData, clients))
+	assert.Equal(t, "", resourceData.Id())
+
+	resourceData = newResourceData()
+	assert.Empty(t, resourceBitbucketRepositorySettingsDelete(context.Background(), resourceData, clients))
+	assert.Equal(t, "", resourceData.Id())
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	gobb "github.com/ktrysmt/go-bitbucket"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -48,6 +49,7 @@ func TestAccBitbucketRepositoryResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "description", ""),
 					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "is_private", "true"),
 					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "has_wiki", "false"),
+					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "has_issues", "false"),
 					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "fork_policy", "no_forks"),
 					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "enable_pipelines", "false"),
 					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "language", ""),
@@ -80,6 +82,7 @@ func TestAccBitbucketRepositoryResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "description", repoDescription),
 					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "is_private", "true"),
 					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "has_wiki", "false"),
+					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "has_issues", "false"),
 					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "fork_policy", repoForkPolicy),
 					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "enable_pipelines", "false"),
 					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "language", ""),
@@ -106,6 +109,8 @@ func TestAccBitbucketRepositoryResource_basic(t *testing.T) {
 					  fork_policy      = "%s"
 					  enable_pipelines = true
 					  has_wiki         = true
+					  has_issues       = true
+					  main_branch      = "develop"
                       language         = "go"
 					}`, workspaceSlug, projectName, projectKey, repoName, repoDescription, repoForkPolicy),
 				Check: resource.ComposeTestCheckFunc(
@@ -115,6 +120,8 @@ func TestAccBitbucketRepositoryResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "description", repoDescription),
 					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "is_private", "true"),
 					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "has_wiki", "true"),
+					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "has_issues", "true"),
+					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "main_branch", "develop"),
 					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "fork_policy", repoForkPolicy),
 					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "enable_pipelines", "true"),
 					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "language", "go"),
@@ -133,6 +140,204 @@ func TestAccBitbucketRepositoryResource_basic(t *testing.T) {
 	})
 }
 
+func TestAccBitbucketRepositoryResource_renameAndMove(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	projectName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectKey := strings.ToUpper(acctest.RandStringFromCharSet(3, acctest.CharSetAlpha))
+	newProjectKey := strings.ToUpper(acctest.RandStringFromCharSet(3, acctest.CharSetAlpha))
+	repoName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	newRepoName := "TF ACC Test Renamed " + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	var repositoryId string
+
+	config := func(repoName string, projectResource string) string {
+		return fmt.Sprintf(`
+			data "bitbucket_workspace" "testacc" {
+				id = "%s"
+			}
+
+			resource "bitbucket_project" "testacc" {
+			  workspace = data.bitbucket_workspace.testacc.id
+			  name      = "%s"
+			  key       = "%s"
+			}
+
+			resource "bitbucket_project" "testacc_new" {
+			  workspace = data.bitbucket_workspace.testacc.id
+			  name      = "%s-new"
+			  key       = "%s"
+			}
+
+			resource "bitbucket_repository" "testacc" {
+			  workspace   = data.bitbucket_workspace.testacc.id
+			  project_key = %s.key
+			  name        = "%s"
+			}`, workspaceSlug, projectName, projectKey, projectName, newProjectKey, projectResource, repoName)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config(repoName, "bitbucket_project.testacc"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "name", repoName),
+					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "slug", repoName),
+					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "project_key", projectKey),
+					func(state *terraform.State) error {
+						repositoryId = state.RootModule().Resources["bitbucket_repository.testacc"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: config(newRepoName, "bitbucket_project.testacc_new"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "name", newRepoName),
+					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "slug", repositorySlug(newRepoName)),
+					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "project_key", newProjectKey),
+					func(state *terraform.State) error {
+						if id := state.RootModule().Resources["bitbucket_repository.testacc"].Primary.ID; id != repositoryId {
+							return fmt.Errorf("expected repository to be updated in place, but its ID changed from %s to %s", repositoryId, id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccBitbucketRepositoryResource_fork(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	projectName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectKey := strings.ToUpper(acctest.RandStringFromCharSet(3, acctest.CharSetAlpha))
+	repoName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	forkName := repoName + "-fork"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_project" "testacc" {
+					  workspace = data.bitbucket_workspace.testacc.id
+					  name      = "%s"
+					  key       = "%s"
+					}
+
+					resource "bitbucket_repository" "testacc" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  project_key = bitbucket_project.testacc.key
+					  name        = "%s"
+					  fork_policy = "allow_forks"
+					}
+
+					resource "bitbucket_repository" "testacc_fork" {
+					  workspace        = data.bitbucket_workspace.testacc.id
+					  project_key      = bitbucket_project.testacc.key
+					  name             = "%s"
+					  description      = "Forked from a template"
+					  enable_pipelines = true
+
+					  fork_from {
+					    workspace  = data.bitbucket_workspace.testacc.id
+					    repository = bitbucket_repository.testacc.slug
+					  }
+					}`, workspaceSlug, projectName, projectKey, repoName, forkName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_repository.testacc_fork", "name", forkName),
+					resource.TestCheckResourceAttr("bitbucket_repository.testacc_fork", "description", "Forked from a template"),
+					resource.TestCheckResourceAttr("bitbucket_repository.testacc_fork", "enable_pipelines", "true"),
+					resource.TestCheckResourceAttr("bitbucket_repository.testacc_fork", "fork_from.#", "1"),
+					resource.TestCheckResourceAttr("bitbucket_repository.testacc_fork", "fork_from.0.workspace", workspaceSlug),
+					resource.TestCheckResourceAttr("bitbucket_repository.testacc_fork", "fork_from.0.repository", repoName),
+					resource.TestCheckResourceAttr("bitbucket_repository.testacc", "fork_from.#", "0"),
+				),
+			},
+			{
+				// A fork managed without `fork_from` must not be replaced
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_project" "testacc" {
+					  workspace = data.bitbucket_workspace.testacc.id
+					  name      = "%s"
+					  key       = "%s"
+					}
+
+					resource "bitbucket_repository" "testacc" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  project_key = bitbucket_project.testacc.key
+					  name        = "%s"
+					  fork_policy = "allow_forks"
+					}
+
+					resource "bitbucket_repository" "testacc_fork" {
+					  workspace        = data.bitbucket_workspace.testacc.id
+					  project_key      = bitbucket_project.testacc.key
+					  name             = "%s"
+					  description      = "Forked from a template"
+					  enable_pipelines = true
+					}`, workspaceSlug, projectName, projectKey, repoName, forkName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestFlattenRepositoryForkFrom(t *testing.T) {
+	parent := &gobb.Repository{
+		Uuid:      "{repository-uuid}",
+		Slug:      "template",
+		Full_name: "workspace-slug/template",
+		Owner: map[string]interface{}{
+			"uuid": "{workspace-uuid}",
+		},
+	}
+
+	assert.Nil(t, flattenRepositoryForkFrom(nil, nil))
+
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"workspace": "workspace-slug", "repository": "template"},
+	}, flattenRepositoryForkFrom(parent, nil))
+
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"workspace": "{workspace-uuid}", "repository": "{repository-uuid}"},
+	}, flattenRepositoryForkFrom(parent, []interface{}{
+		map[string]interface{}{"workspace": "{workspace-uuid}", "repository": "{repository-uuid}"},
+	}))
+
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"workspace": "workspace-slug", "repository": "template"},
+	}, flattenRepositoryForkFrom(parent, []interface{}{
+		map[string]interface{}{"workspace": "another-workspace", "repository": "another-template"},
+	}))
+}
+
+func TestIsRepositoryForkReady(t *testing.T) {
+	empty := &gobb.Repository{}
+	withMainBranch := &gobb.Repository{Mainbranch: gobb.RepositoryBranch{Name: "master"}}
+
+	assert.True(t, isRepositoryForkReady(empty, empty))
+	assert.False(t, isRepositoryForkReady(empty, withMainBranch))
+	assert.True(t, isRepositoryForkReady(withMainBranch, withMainBranch))
+}
+
+func TestRepositorySlug(t *testing.T) {
+	assert.Equal(t, "example-repo", repositorySlug("example-repo"))
+	assert.Equal(t, "my-repo", repositorySlug("My Repo"))
+	assert.Equal(t, "my-repo_v2.0", repositorySlug("  My  Repo_v2.0!"))
+}
+
 func TestValidateRepositoryName(t *testing.T) {
 	invalidName := "ABC!@£"
 	validator := validateRepositoryName(invalidName, nil)
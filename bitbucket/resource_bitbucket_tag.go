@@ -0,0 +1,162 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+func resourceBitbucketTag() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBitbucketTagCreate,
+		ReadContext:   resourceBitbucketTagRead,
+		DeleteContext: resourceBitbucketTagDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBitbucketTagImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The ID of the tag.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"workspace": {
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"repository": {
+				Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateRepositoryName,
+			},
+			"name": {
+				Description: "The name of the tag.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"target": {
+				Description: "The commit hash, or name of an existing branch, to tag.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"message": {
+				Description: "The message of the tag.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				DiffSuppressFunc: func(k, old, new string, resourceData *schema.ResourceData) bool {
+					return strings.TrimSpace(old) == strings.TrimSpace(new)
+				},
+			},
+			"hash": {
+				Description: "The hash of the commit the tag points to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceBitbucketTagCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	_, err := client.Refs.CreateTag(
+		&v2.RefOptions{
+			Owner:    resourceData.Get("workspace").(string),
+			RepoSlug: resourceData.Get("repository").(string),
+			Ref: &v2.Ref{
+				Name:    resourceData.Get("name").(string),
+				Message: resourceData.Get("message").(string),
+				Target: v2.RefTarget{
+					Hash: resourceData.Get("target").(string),
+				},
+			},
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to create tag with error: %s", err))
+	}
+
+	return resourceBitbucketTagRead(ctx, resourceData, meta)
+}
+
+func resourceBitbucketTagRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	tag, err := client.Refs.GetTag(
+		&v2.RefOptions{
+			Owner:    resourceData.Get("workspace").(string),
+			RepoSlug: resourceData.Get("repository").(string),
+			Name:     resourceData.Get("name").(string),
+		},
+	)
+	if err != nil {
+		// If the tag has been deleted outside of Terraform, we remove it from state so that it will be re-created.
+		if v2.IsNotFound(err) && resourceData.Id() != "" {
+			resourceData.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(fmt.Errorf("unable to get tag with error: %s", err))
+	}
+
+	_ = resourceData.Set("message", tag.Message)
+	_ = resourceData.Set("hash", tag.Target.Hash)
+
+	resourceData.SetId(generateRefId(
+		resourceData.Get("workspace").(string),
+		resourceData.Get("repository").(string),
+		tag.Name,
+	))
+
+	return nil
+}
+
+func resourceBitbucketTagDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	err := client.Refs.DeleteTag(
+		&v2.RefOptions{
+			Owner:    resourceData.Get("workspace").(string),
+			RepoSlug: resourceData.Get("repository").(string),
+			Name:     resourceData.Get("name").(string),
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to delete tag with error: %s", err))
+	}
+
+	resourceData.SetId("")
+
+	return nil
+}
+
+func resourceBitbucketTagImport(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ret := []*schema.ResourceData{resourceData}
+
+	splitID := strings.SplitN(resourceData.Id(), "/", 3)
+	if len(splitID) < 3 {
+		return ret, fmt.Errorf("invalid import ID. It must to be in this format \"<workspace-slug|workspace-uuid>/<repository-name>/<tag-name>\"")
+	}
+
+	_ = resourceData.Set("workspace", splitID[0])
+	_ = resourceData.Set("repository", splitID[1])
+	_ = resourceData.Set("name", splitID[2])
+
+	_ = resourceBitbucketTagRead(ctx, resourceData, meta)
+
+	_ = resourceData.Set("target", resourceData.Get("hash").(string))
+
+	return ret, nil
+}
@@ -0,0 +1,77 @@
+package bitbucket
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccBitbucketTagResource_basic(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	projectName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	projectKey := strings.ToUpper(acctest.RandStringFromCharSet(3, acctest.CharSetAlpha))
+	repoName := "tf-acc-test-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	tagName := "v1.0.0"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_project" "testacc" {
+					  workspace = data.bitbucket_workspace.testacc.id
+					  name      = "%s"
+					  key       = "%s"
+					}
+
+					resource "bitbucket_repository" "testacc" {
+					  workspace   = data.bitbucket_workspace.testacc.id
+					  project_key = bitbucket_project.testacc.key
+					  name        = "%s"
+					}
+
+					resource "bitbucket_repository_file" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository.testacc.slug
+					  branch     = "master"
+					  path       = "README.md"
+					  content    = "# TF ACC Test Repository"
+					}
+
+					resource "bitbucket_tag" "testacc" {
+					  workspace  = data.bitbucket_workspace.testacc.id
+					  repository = bitbucket_repository_file.testacc.repository
+					  name       = "%s"
+					  target     = bitbucket_repository_file.testacc.branch
+					  message    = "TF ACC Test Release"
+					}`, workspaceSlug, projectName, projectKey, repoName, tagName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_tag.testacc", "id", fmt.Sprintf("%s/%s/%s", workspaceSlug, repoName, tagName)),
+					resource.TestCheckResourceAttr("bitbucket_tag.testacc", "workspace", workspaceSlug),
+					resource.TestCheckResourceAttr("bitbucket_tag.testacc", "repository", repoName),
+					resource.TestCheckResourceAttr("bitbucket_tag.testacc", "name", tagName),
+					resource.TestCheckResourceAttr("bitbucket_tag.testacc", "target", "master"),
+					resource.TestCheckResourceAttr("bitbucket_tag.testacc", "message", "TF ACC Test Release"),
+					resource.TestMatchResourceAttr("bitbucket_tag.testacc", "hash", regexp.MustCompile("^[0-9a-f]{40}$")),
+				),
+			},
+			{
+				ResourceName:            "bitbucket_tag.testacc",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s/%s/%s", workspaceSlug, repoName, tagName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"target"},
+			},
+		},
+	})
+}
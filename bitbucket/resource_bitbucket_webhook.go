@@ -3,12 +3,15 @@ package bitbucket
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	gobb "github.com/ktrysmt/go-bitbucket"
+	"golang.org/x/exp/slices"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
 )
 
 func resourceBitbucketWebhook() *schema.Resource {
@@ -20,110 +23,137 @@ func resourceBitbucketWebhook() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceBitbucketWebhookImport,
 		},
-		Schema: map[string]*schema.Schema{
-			"id": {
-				Description: "The UUID of the webhook.",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
-			"workspace": {
-				Description: "The slug or UUID (including the enclosing `{}`) of the workspace this webhook belongs to.",
-				Type:        schema.TypeString,
-				Required:    true,
-			},
-			"repository": {
-				Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
-				Type:             schema.TypeString,
-				Required:         true,
-				ValidateDiagFunc: validateRepositoryName,
-			},
-			"name": {
-				Description: "The name of the webhook.",
-				Type:        schema.TypeString,
-				Required:    true,
+		CustomizeDiff: validateWebhookEvents("repository"),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceBitbucketWebhookV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: upgradeStateListsToSets,
 			},
-			"url": {
-				Description:  "The url to configure the webhook with.",
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
-			},
-			"events": {
-				Description: "A list of events that will trigger the webhook - see docs for a complete list.",
-				Type:        schema.TypeList,
-				Elem: &schema.Schema{
-					Type:         schema.TypeString,
-					ValidateFunc: validation.StringInSlice([]string{"pullrequest:unapproved", "issue:comment_created", "repo:imported", "repo:created", "repo:commit_comment_created", "pullrequest:approved", "pullrequest:comment_updated", "issue:updated", "project:updated", "repo:deleted", "pullrequest:changes_request_created", "pullrequest:comment_created", "repo:commit_status_updated", "pullrequest:updated", "issue:created", "repo:fork", "pullrequest:comment_deleted", "repo:commit_status_created", "repo:updated", "pullrequest:rejected", "pullrequest:fulfilled", "pullrequest:created", "pullrequest:changes_request_removed", "repo:transfer", "repo:push"}, false),
-				},
-				Required: true,
-			},
-			"is_active": {
-				Description: "A boolean to state if the webhook is active or not.",
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
+		},
+		Schema: resourceBitbucketWebhookSchema(),
+	}
+}
+
+func resourceBitbucketWebhookSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: "The UUID of the webhook.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"workspace": {
+			Description: "The slug or UUID (including the enclosing `{}`) of the workspace this webhook belongs to.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"repository": {
+			Description:      "The name of the repository (must consist of only lowercase ASCII letters, numbers, underscores and hyphens).",
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: validateRepositoryName,
+		},
+		"name": {
+			Description: "The name of the webhook.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"url": {
+			Description:  "The url to configure the webhook with.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+		"events": {
+			Description: "A set of events that will trigger the webhook - see the `bitbucket_hook_events` data source for a complete list.",
+			Type:        schema.TypeSet,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
 			},
+			Required: true,
+		},
+		"is_active": {
+			Description: "A boolean to state if the webhook is active or not.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"secret": {
+			Description: "A secret Bitbucket uses to sign each request the webhook makes, so that the receiver can verify it. This is never read back from Bitbucket.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"secret_set": {
+			Description: "A boolean to state if the webhook has a secret or not.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"skip_cert_verification": {
+			Description: "A boolean to state if the SSL certificate of the webhook's url should not be verified.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
 		},
 	}
 }
 
+// resourceBitbucketWebhookV0 is the resource as it was before `events` became a set.
+func resourceBitbucketWebhookV0() *schema.Resource {
+	resourceSchema := resourceBitbucketWebhookSchema()
+	resourceSchema["events"].Type = schema.TypeList
+
+	return &schema.Resource{Schema: resourceSchema}
+}
+
 func resourceBitbucketWebhookCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).V2
+	client := meta.(*Clients).V2Ext
 
-	webhook, err := client.Repositories.Webhooks.Create(
-		&gobb.WebhooksOptions{
-			Owner:       resourceData.Get("workspace").(string),
-			RepoSlug:    resourceData.Get("repository").(string),
-			Description: resourceData.Get("name").(string),
-			Url:         resourceData.Get("url").(string),
-			Active:      resourceData.Get("is_active").(bool),
-			Events:      convertEventsToStringArray(resourceData.Get("events").([]interface{})),
+	webhook, err := client.Webhooks.Create(
+		&v2.WebhookOptions{
+			Owner:    resourceData.Get("workspace").(string),
+			RepoSlug: resourceData.Get("repository").(string),
+			Webhook:  expandWebhook(resourceData, convertEventsToStringArray(resourceData.Get("events").(*schema.Set).List())),
 		},
 	)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to create webhook with error: %s", err))
 	}
 
-	resourceData.SetId(webhook.Uuid)
+	resourceData.SetId(webhook.UUID)
 
 	return resourceBitbucketWebhookRead(ctx, resourceData, meta)
 }
 
 func resourceBitbucketWebhookRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).V2
+	client := meta.(*Clients).V2Ext
 
-	webhook, err := client.Repositories.Webhooks.Get(
-		&gobb.WebhooksOptions{
+	webhook, err := client.Webhooks.Get(
+		&v2.WebhookOptions{
 			Owner:    resourceData.Get("workspace").(string),
 			RepoSlug: resourceData.Get("repository").(string),
-			Uuid:     resourceData.Get("id").(string),
+			UUID:     resourceData.Get("id").(string),
 		},
 	)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("unable to get webhook with error: %s", err))
 	}
 
-	_ = resourceData.Set("name", webhook.Description)
-	_ = resourceData.Set("url", webhook.Url)
-	_ = resourceData.Set("is_active", webhook.Active)
-	_ = resourceData.Set("events", webhook.Events)
-	resourceData.SetId(webhook.Uuid)
+	flattenWebhook(resourceData, webhook)
 
 	return nil
 }
 
 func resourceBitbucketWebhookUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).V2
-
-	_, err := client.Repositories.Webhooks.Update(
-		&gobb.WebhooksOptions{
-			Uuid:        resourceData.Id(),
-			Owner:       resourceData.Get("workspace").(string),
-			RepoSlug:    resourceData.Get("repository").(string),
-			Description: resourceData.Get("name").(string),
-			Url:         resourceData.Get("url").(string),
-			Active:      resourceData.Get("is_active").(bool),
-			Events:      convertEventsToStringArray(resourceData.Get("events").([]interface{})),
+	client := meta.(*Clients).V2Ext
+
+	_, err := client.Webhooks.Update(
+		&v2.WebhookOptions{
+			Owner:    resourceData.Get("workspace").(string),
+			RepoSlug: resourceData.Get("repository").(string),
+			UUID:     resourceData.Id(),
+			Webhook:  expandWebhook(resourceData, convertEventsToStringArray(resourceData.Get("events").(*schema.Set).List())),
 		},
 	)
 	if err != nil {
@@ -134,13 +164,13 @@ func resourceBitbucketWebhookUpdate(ctx context.Context, resourceData *schema.Re
 }
 
 func resourceBitbucketWebhookDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*Clients).V2
+	client := meta.(*Clients).V2Ext
 
-	_, err := client.Repositories.Webhooks.Delete(
-		&gobb.WebhooksOptions{
+	err := client.Webhooks.Delete(
+		&v2.WebhookOptions{
 			Owner:    resourceData.Get("workspace").(string),
 			RepoSlug: resourceData.Get("repository").(string),
-			Uuid:     resourceData.Id(),
+			UUID:     resourceData.Id(),
 		},
 	)
 	if err != nil {
@@ -169,6 +199,75 @@ func resourceBitbucketWebhookImport(ctx context.Context, resourceData *schema.Re
 	return ret, nil
 }
 
+// expandWebhook returns the webhook to send to Bitbucket, shared by repository & workspace webhooks. The secret is only
+// sent when creating the webhook or when it has changed, as otherwise Bitbucket keeps the one it already has.
+func expandWebhook(resourceData *schema.ResourceData, events []string) *v2.Webhook {
+	webhook := &v2.Webhook{
+		Description:          resourceData.Get("name").(string),
+		URL:                  resourceData.Get("url").(string),
+		Active:               resourceData.Get("is_active").(bool),
+		Events:               events,
+		SkipCertVerification: resourceData.Get("skip_cert_verification").(bool),
+	}
+
+	secret := resourceData.Get("secret").(string)
+	if (resourceData.IsNewResource() && secret != "") || (!resourceData.IsNewResource() && resourceData.HasChange("secret")) {
+		webhook.Secret = &secret
+	}
+
+	return webhook
+}
+
+func flattenWebhook(resourceData *schema.ResourceData, webhook *v2.Webhook) {
+	_ = resourceData.Set("name", webhook.Description)
+	_ = resourceData.Set("url", webhook.URL)
+	_ = resourceData.Set("is_active", webhook.Active)
+	_ = resourceData.Set("events", webhook.Events)
+	_ = resourceData.Set("secret_set", webhook.SecretSet)
+	_ = resourceData.Set("skip_cert_verification", webhook.SkipCertVerification)
+
+	// Bitbucket never returns the secret, but if it has been removed outside of Terraform we clear it, so that the
+	// next plan will set it again.
+	if !webhook.SecretSet {
+		_ = resourceData.Set("secret", "")
+	}
+
+	resourceData.SetId(webhook.UUID)
+}
+
+// validateWebhookEvents checks the configured events against those Bitbucket supports for webhooks of the given
+// subject type, so that an unsupported event is caught when planning rather than applying.
+func validateWebhookEvents(subjectType string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if !diff.NewValueKnown("events") {
+			return nil
+		}
+
+		hookEvents, err := meta.(*Clients).HookEvents(subjectType)
+		if err != nil {
+			return fmt.Errorf("unable to get %s webhook events with error: %s", subjectType, err)
+		}
+
+		return checkWebhookEvents(convertEventsToStringArray(diff.Get("events").(*schema.Set).List()), hookEvents)
+	}
+}
+
+func checkWebhookEvents(events []string, hookEvents []v2.HookEvent) error {
+	var supported []string
+	for _, hookEvent := range hookEvents {
+		supported = append(supported, hookEvent.Event)
+	}
+	sort.Strings(supported)
+
+	for _, event := range events {
+		if !slices.Contains(supported, event) {
+			return fmt.Errorf("expected events to be one of %q, got %s", supported, event)
+		}
+	}
+
+	return nil
+}
+
 func convertEventsToStringArray(events []interface{}) []string {
 	var eventArray []string
 
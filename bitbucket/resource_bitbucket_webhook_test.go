@@ -1,6 +1,7 @@
 package bitbucket
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -8,8 +9,11 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
 )
 
 func TestAccBitbucketWebhookResource_basic(t *testing.T) {
@@ -55,9 +59,11 @@ func TestAccBitbucketWebhookResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("bitbucket_webhook.testacc", "name", webhookName),
 					resource.TestCheckResourceAttr("bitbucket_webhook.testacc", "url", webhookUrl),
 					resource.TestCheckResourceAttr("bitbucket_webhook.testacc", "is_active", "false"),
+					resource.TestCheckResourceAttr("bitbucket_webhook.testacc", "secret_set", "false"),
+					resource.TestCheckResourceAttr("bitbucket_webhook.testacc", "skip_cert_verification", "false"),
 
 					resource.TestCheckResourceAttr("bitbucket_webhook.testacc", "events.#", "1"),
-					resource.TestCheckResourceAttr("bitbucket_webhook.testacc", "events.0", "pullrequest:approved"),
+					resource.TestCheckTypeSetElemAttr("bitbucket_webhook.testacc", "events.*", "pullrequest:approved"),
 
 					resource.TestCheckResourceAttrSet("bitbucket_webhook.testacc", "id"),
 				),
@@ -87,6 +93,9 @@ func TestAccBitbucketWebhookResource_basic(t *testing.T) {
 					  url        = "%s"
 					  events     = ["pullrequest:approved", "pullrequest:unapproved"]
 					  is_active  = true
+					  secret     = "testacc-secret"
+
+					  skip_cert_verification = true
 					}`, workspaceSlug, projectName, projectKey, repoName, webhookName, webhookUrl),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("bitbucket_webhook.testacc", "workspace", workspaceSlug),
@@ -94,18 +103,22 @@ func TestAccBitbucketWebhookResource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("bitbucket_webhook.testacc", "name", webhookName),
 					resource.TestCheckResourceAttr("bitbucket_webhook.testacc", "url", webhookUrl),
 					resource.TestCheckResourceAttr("bitbucket_webhook.testacc", "is_active", "true"),
+					resource.TestCheckResourceAttr("bitbucket_webhook.testacc", "secret", "testacc-secret"),
+					resource.TestCheckResourceAttr("bitbucket_webhook.testacc", "secret_set", "true"),
+					resource.TestCheckResourceAttr("bitbucket_webhook.testacc", "skip_cert_verification", "true"),
 
 					resource.TestCheckResourceAttr("bitbucket_webhook.testacc", "events.#", "2"),
-					resource.TestCheckResourceAttr("bitbucket_webhook.testacc", "events.0", "pullrequest:approved"),
-					resource.TestCheckResourceAttr("bitbucket_webhook.testacc", "events.1", "pullrequest:unapproved"),
+					resource.TestCheckTypeSetElemAttr("bitbucket_webhook.testacc", "events.*", "pullrequest:approved"),
+					resource.TestCheckTypeSetElemAttr("bitbucket_webhook.testacc", "events.*", "pullrequest:unapproved"),
 
 					resource.TestCheckResourceAttrSet("bitbucket_webhook.testacc", "id"),
 				),
 			},
 			{
-				ResourceName:      "bitbucket_webhook.testacc",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "bitbucket_webhook.testacc",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
 				ImportStateIdFunc: func(state *terraform.State) (string, error) {
 					resources := state.Modules[0].Resources
 					webhookResourceAttr := resources["bitbucket_webhook.testacc"].Primary.Attributes
@@ -123,3 +136,151 @@ func TestConvertEventsToStringArray(t *testing.T) {
 	expected := []string{"a", "b", "c"}
 	assert.Equal(t, expected, eventsStrArr)
 }
+
+func TestExpandWebhook(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, resourceBitbucketWebhook().Schema, map[string]interface{}{
+		"workspace":              "workspace",
+		"repository":             "repository",
+		"name":                   "webhook",
+		"url":                    "https://example.com",
+		"events":                 []interface{}{"repo:push"},
+		"secret":                 "secret",
+		"skip_cert_verification": true,
+	})
+
+	webhook := expandWebhook(resourceData, []string{"repo:push"})
+	assert.Equal(t, "webhook", webhook.Description)
+	assert.Equal(t, "https://example.com", webhook.URL)
+	assert.Equal(t, []string{"repo:push"}, webhook.Events)
+	assert.True(t, webhook.SkipCertVerification)
+	if assert.NotNil(t, webhook.Secret) {
+		assert.Equal(t, "secret", *webhook.Secret)
+	}
+
+	resourceData = schema.TestResourceDataRaw(t, resourceBitbucketWebhook().Schema, map[string]interface{}{
+		"workspace":  "workspace",
+		"repository": "repository",
+		"name":       "webhook",
+		"url":        "https://example.com",
+		"events":     []interface{}{"repo:push"},
+	})
+
+	webhook = expandWebhook(resourceData, []string{"repo:push"})
+	assert.Nil(t, webhook.Secret)
+}
+
+func TestCheckWebhookEvents(t *testing.T) {
+	hookEvents := []v2.HookEvent{
+		{Event: "repo:push"},
+		{Event: "pullrequest:push"},
+	}
+
+	assert.NoError(t, checkWebhookEvents([]string{"repo:push", "pullrequest:push"}, hookEvents))
+	assert.EqualError(
+		t,
+		checkWebhookEvents([]string{"repo:push", "repo:unknown"}, hookEvents),
+		`expected events to be one of ["pullrequest:push" "repo:push"], got repo:unknown`,
+	)
+}
+
+func TestResourceBitbucketWebhookStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"id":         "{webhook-uuid}",
+		"workspace":  "workspace",
+		"repository": "repository",
+		"name":       "webhook",
+		"url":        "https://example.com",
+		"events":     []interface{}{"repo:push", "pullrequest:created"},
+		"is_active":  true,
+	}
+
+	upgrader := resourceBitbucketWebhook().StateUpgraders[0]
+	assert.Equal(t, 0, upgrader.Version)
+	assert.Equal(t, resourceBitbucketWebhookV0().CoreConfigSchema().ImpliedType(), upgrader.Type)
+
+	upgraded, err := upgrader.Upgrade(context.Background(), rawState, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"repo:push", "pullrequest:created"}, upgraded["events"])
+
+	// The upgraded state's events are read as a set, in which the order they were listed in no longer matters
+	resourceData := schema.TestResourceDataRaw(t, resourceBitbucketWebhook().Schema, upgraded)
+	assert.ElementsMatch(t, []interface{}{"pullrequest:created", "repo:push"}, resourceData.Get("events").(*schema.Set).List())
+	assert.Equal(t, "webhook", resourceData.Get("name"))
+}
+
+func TestExpandWebhookSecretOnlySentWhenChanged(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "{webhook-uuid}",
+		Attributes: map[string]string{
+			"id":                     "{webhook-uuid}",
+			"workspace":              "workspace",
+			"repository":             "repository",
+			"name":                   "webhook",
+			"url":                    "https://example.com",
+			"events.#":               "1",
+			"events.0":               "repo:push",
+			"is_active":              "false",
+			"secret":                 "secret",
+			"secret_set":             "true",
+			"skip_cert_verification": "false",
+		},
+	}
+	expand := func(secret interface{}) *v2.Webhook {
+		config := map[string]interface{}{
+			"workspace":  "workspace",
+			"repository": "repository",
+			"name":       "webhook",
+			"url":        "https://example.com",
+			"events":     []interface{}{"repo:push"},
+		}
+		if secret != nil {
+			config["secret"] = secret
+		}
+
+		resourceSchema := schema.InternalMap(resourceBitbucketWebhook().Schema)
+		diff, err := resourceSchema.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil, nil, false)
+		assert.NoError(t, err)
+		resourceData, err := resourceSchema.Data(state, diff)
+		assert.NoError(t, err)
+
+		return expandWebhook(resourceData, []string{"repo:push"})
+	}
+
+	// Bitbucket keeps the secret it already has when none is sent
+	assert.Nil(t, expand("secret").Secret)
+
+	if webhook := expand("rotated"); assert.NotNil(t, webhook.Secret) {
+		assert.Equal(t, "rotated", *webhook.Secret)
+	}
+
+	// Removing the secret from the configuration sends an empty one, which removes it from the webhook
+	if webhook := expand(nil); assert.NotNil(t, webhook.Secret) {
+		assert.Equal(t, "", *webhook.Secret)
+	}
+}
+
+func TestFlattenWebhookSecret(t *testing.T) {
+	newResourceData := func() *schema.ResourceData {
+		return schema.TestResourceDataRaw(t, resourceBitbucketWebhook().Schema, map[string]interface{}{
+			"workspace":  "workspace",
+			"repository": "repository",
+			"name":       "webhook",
+			"url":        "https://example.com",
+			"events":     []interface{}{"repo:push"},
+			"secret":     "secret",
+		})
+	}
+	returned := "returned"
+
+	// The secret is never read back, even if Bitbucket were to return one
+	resourceData := newResourceData()
+	flattenWebhook(resourceData, &v2.Webhook{UUID: "{webhook-uuid}", Secret: &returned, SecretSet: true})
+	assert.Equal(t, "secret", resourceData.Get("secret"))
+	assert.True(t, resourceData.Get("secret_set").(bool))
+
+	// The secret has been removed outside of Terraform, so it is cleared to be set again
+	resourceData = newResourceData()
+	flattenWebhook(resourceData, &v2.Webhook{UUID: "{webhook-uuid}", SecretSet: false})
+	assert.Equal(t, "", resourceData.Get("secret"))
+	assert.False(t, resourceData.Get("secret_set").(bool))
+}
@@ -0,0 +1,174 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+func resourceBitbucketWorkspaceWebhook() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceBitbucketWorkspaceWebhookCreate,
+		ReadContext:   resourceBitbucketWorkspaceWebhookRead,
+		UpdateContext: resourceBitbucketWorkspaceWebhookUpdate,
+		DeleteContext: resourceBitbucketWorkspaceWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceBitbucketWorkspaceWebhookImport,
+		},
+		CustomizeDiff: validateWebhookEvents("workspace"),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The UUID of the webhook.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"workspace": {
+				Description: "The slug or UUID (including the enclosing `{}`) of the workspace this webhook belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the webhook.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"url": {
+				Description:  "The url to configure the webhook with.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"events": {
+				Description: "A set of events that will trigger the webhook, for any repository in the workspace - see the `bitbucket_hook_events` data source for a complete list.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Required: true,
+			},
+			"is_active": {
+				Description: "A boolean to state if the webhook is active or not.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"secret": {
+				Description: "A secret Bitbucket uses to sign each request the webhook makes, so that the receiver can verify it. This is never read back from Bitbucket.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"secret_set": {
+				Description: "A boolean to state if the webhook has a secret or not.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"skip_cert_verification": {
+				Description: "A boolean to state if the SSL certificate of the webhook's url should not be verified.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceBitbucketWorkspaceWebhookCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	webhook, err := client.Webhooks.Create(
+		&v2.WebhookOptions{
+			Owner:   resourceData.Get("workspace").(string),
+			Webhook: expandWebhook(resourceData, convertEventsToStringArray(resourceData.Get("events").(*schema.Set).List())),
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to create workspace webhook with error: %s", err))
+	}
+
+	resourceData.SetId(webhook.UUID)
+
+	return resourceBitbucketWorkspaceWebhookRead(ctx, resourceData, meta)
+}
+
+func resourceBitbucketWorkspaceWebhookRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	webhook, err := client.Webhooks.Get(
+		&v2.WebhookOptions{
+			Owner: resourceData.Get("workspace").(string),
+			UUID:  resourceData.Get("id").(string),
+		},
+	)
+	if err != nil {
+		// If the webhook has been removed outside of Terraform, we remove it from state so that it will be re-created.
+		if v2.IsNotFound(err) && resourceData.Id() != "" {
+			resourceData.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(fmt.Errorf("unable to get workspace webhook with error: %s", err))
+	}
+
+	flattenWebhook(resourceData, webhook)
+
+	return nil
+}
+
+func resourceBitbucketWorkspaceWebhookUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	_, err := client.Webhooks.Update(
+		&v2.WebhookOptions{
+			Owner:   resourceData.Get("workspace").(string),
+			UUID:    resourceData.Id(),
+			Webhook: expandWebhook(resourceData, convertEventsToStringArray(resourceData.Get("events").(*schema.Set).List())),
+		},
+	)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("unable to update workspace webhook with error: %s", err))
+	}
+
+	return resourceBitbucketWorkspaceWebhookRead(ctx, resourceData, meta)
+}
+
+func resourceBitbucketWorkspaceWebhookDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Clients).V2Ext
+
+	err := client.Webhooks.Delete(
+		&v2.WebhookOptions{
+			Owner: resourceData.Get("workspace").(string),
+			UUID:  resourceData.Id(),
+		},
+	)
+	if err != nil && !v2.IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("unable to delete workspace webhook with error: %s", err))
+	}
+
+	resourceData.SetId("")
+
+	return nil
+}
+
+func resourceBitbucketWorkspaceWebhookImport(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ret := []*schema.ResourceData{resourceData}
+
+	splitID := strings.Split(resourceData.Id(), "/")
+	if len(splitID) < 2 {
+		return ret, fmt.Errorf("invalid import ID. It must to be in this format \"<workspace-slug|workspace-uuid>/<webhook-uuid>\"")
+	}
+
+	_ = resourceData.Set("workspace", splitID[0])
+	resourceData.SetId(splitID[1])
+
+	_ = resourceBitbucketWorkspaceWebhookRead(ctx, resourceData, meta)
+
+	return ret, nil
+}
@@ -0,0 +1,112 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+
+	v2 "github.com/zahiar/terraform-provider-bitbucket/bitbucket/api/v2"
+)
+
+func TestAccBitbucketWorkspaceWebhookResource_basic(t *testing.T) {
+	workspaceSlug := os.Getenv("BITBUCKET_USERNAME")
+	webhookName := "TF ACC Test Workspace Webhook"
+	webhookUrl := "https://example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_workspace_webhook" "testacc" {
+					  workspace = data.bitbucket_workspace.testacc.id
+					  name      = "%s"
+					  url       = "%s"
+					  events    = ["repo:created"]
+					}`, workspaceSlug, webhookName, webhookUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_workspace_webhook.testacc", "workspace", workspaceSlug),
+					resource.TestCheckResourceAttr("bitbucket_workspace_webhook.testacc", "name", webhookName),
+					resource.TestCheckResourceAttr("bitbucket_workspace_webhook.testacc", "url", webhookUrl),
+					resource.TestCheckResourceAttr("bitbucket_workspace_webhook.testacc", "is_active", "false"),
+					resource.TestCheckResourceAttr("bitbucket_workspace_webhook.testacc", "secret_set", "false"),
+					resource.TestCheckResourceAttr("bitbucket_workspace_webhook.testacc", "skip_cert_verification", "false"),
+
+					resource.TestCheckResourceAttr("bitbucket_workspace_webhook.testacc", "events.#", "1"),
+					resource.TestCheckTypeSetElemAttr("bitbucket_workspace_webhook.testacc", "events.*", "repo:created"),
+
+					resource.TestCheckResourceAttrSet("bitbucket_workspace_webhook.testacc", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "bitbucket_workspace" "testacc" {
+						id = "%s"
+					}
+
+					resource "bitbucket_workspace_webhook" "testacc" {
+					  workspace = data.bitbucket_workspace.testacc.id
+					  name      = "%s"
+					  url       = "%s"
+					  events    = ["repo:created", "repo:push"]
+					  is_active = true
+					  secret    = "testacc-secret"
+
+					  skip_cert_verification = true
+					}`, workspaceSlug, webhookName, webhookUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("bitbucket_workspace_webhook.testacc", "is_active", "true"),
+					resource.TestCheckResourceAttr("bitbucket_workspace_webhook.testacc", "secret_set", "true"),
+					resource.TestCheckResourceAttr("bitbucket_workspace_webhook.testacc", "skip_cert_verification", "true"),
+
+					resource.TestCheckResourceAttr("bitbucket_workspace_webhook.testacc", "events.#", "2"),
+					resource.TestCheckTypeSetElemAttr("bitbucket_workspace_webhook.testacc", "events.*", "repo:created"),
+					resource.TestCheckTypeSetElemAttr("bitbucket_workspace_webhook.testacc", "events.*", "repo:push"),
+				),
+			},
+			{
+				ResourceName:            "bitbucket_workspace_webhook.testacc",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					resources := state.Modules[0].Resources
+					webhookResourceAttr := resources["bitbucket_workspace_webhook.testacc"].Primary.Attributes
+					return fmt.Sprintf("%s/%s", workspaceSlug, webhookResourceAttr["id"]), nil
+				},
+			},
+		},
+	})
+}
+
+func TestResourceBitbucketWorkspaceWebhookValidatesWorkspaceEvents(t *testing.T) {
+	clients := &Clients{
+		hookEvents: map[string][]v2.HookEvent{
+			"repository": {{Event: "repo:push"}},
+			"workspace":  {{Event: "repo:created"}, {Event: "repo:push"}},
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"workspace": "workspace",
+		"name":      "webhook",
+		"url":       "https://example.com",
+		"events":    []interface{}{"repo:created", "repo:push"},
+	})
+
+	_, err := resourceBitbucketWorkspaceWebhook().Diff(context.Background(), nil, config, clients)
+	assert.NoError(t, err)
+
+	config.Config["repository"] = "repository"
+	_, err = resourceBitbucketWebhook().Diff(context.Background(), nil, config, clients)
+	assert.ErrorContains(t, err, "got repo:created")
+}
@@ -1,16 +1,55 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 
 	"github.com/zahiar/terraform-provider-bitbucket/bitbucket"
 )
 
+// providerAddress is the address the provider is published to the Terraform Registry under.
+const providerAddress = "registry.terraform.io/zahiar/bitbucket"
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "generate-imports" {
+		generateImports(os.Args[2:])
+		return
+	}
+
+	var debug bool
+	flag.BoolVar(&debug, "debug", false, "Run the provider in debug mode, so that a debugger (e.g. Delve) can be attached to it. The TF_REATTACH_PROVIDERS value to run Terraform with is printed on start-up.")
+	flag.Parse()
+
 	plugin.Serve(&plugin.ServeOpts{
+		Debug:        debug,
+		ProviderAddr: providerAddress,
 		ProviderFunc: func() *schema.Provider {
 			return bitbucket.Provider()
 		},
 	})
 }
+
+// generateImports writes the configuration & import blocks for every supported resource within a workspace, using the
+// same BITBUCKET_USERNAME & BITBUCKET_PASSWORD environment variables as the provider to authenticate.
+func generateImports(args []string) {
+	flags := flag.NewFlagSet("generate-imports", flag.ExitOnError)
+	workspace := flags.String("workspace", "", "The slug or UUID of the workspace to scan.")
+	outputDir := flags.String("output-dir", ".", "The directory to write the generated .tf files into.")
+	_ = flags.Parse(args)
+
+	if *workspace == "" {
+		fmt.Fprintln(os.Stderr, "a workspace must be given with -workspace")
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	if err := bitbucket.GenerateImports(context.Background(), *workspace, *outputDir); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}